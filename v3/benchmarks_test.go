@@ -110,6 +110,20 @@ func BenchmarkZlint(b *testing.B) {
 		globalLintResult = lintResult
 	})
 
+	// Using a new Configuration for every certificate configures every lint
+	// once per certificate, for comparison with "All lints".
+	b.Run("All lints configured per certificate", func(b *testing.B) {
+		lints := lint.GlobalRegistry().CertificateLints().Lints()
+		for i := 0; i < b.N; i++ {
+			config := lint.NewEmptyConfig()
+			lintResult := &ResultSet{Results: make(map[string]*lint.LintResult, len(lints))}
+			for _, l := range lints {
+				lintResult.Results[l.Name] = l.Execute(x509Cert, config)
+			}
+			globalLintResult = lintResult
+		}
+	})
+
 	names := lint.GlobalRegistry().Names()
 
 	b.Run("Fast lints", func(b *testing.B) {
@@ -179,3 +193,44 @@ func BenchmarkZlint(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkCertificateContext compares the lints that take their views of a
+// certificate from a CertificateContext when they share one context, as
+// LintCertificate runs them, with when each has a context of its own, as
+// when every lint derived those views for itself.
+func BenchmarkCertificateContext(b *testing.B) {
+	certDerBlock, _ := pem.Decode([]byte(bigCertificatePem))
+	x509Cert, err := x509.ParseCertificate(certDerBlock.Bytes)
+	if err != nil {
+		b.Fatalf("Error parsing certificate: %s", err.Error())
+	}
+	var lints []*lint.CertificateLint
+	for _, l := range lint.GlobalRegistry().CertificateLints().Lints() {
+		if _, ok := l.Lint().(lint.CertificateContextLintInterface); ok {
+			lints = append(lints, l)
+		}
+	}
+	config := lint.NewEmptyConfig()
+
+	b.ResetTimer()
+	b.Run("Shared context", func(b *testing.B) {
+		var result *lint.LintResult
+		for i := 0; i < b.N; i++ {
+			cc := lint.NewCertificateContext(x509Cert)
+			for _, l := range lints {
+				result = l.ExecuteWithContext(cc, config)
+			}
+		}
+		globalSingleLintResult = result
+	})
+
+	b.Run("Context per lint", func(b *testing.B) {
+		var result *lint.LintResult
+		for i := 0; i < b.N; i++ {
+			for _, l := range lints {
+				result = l.Execute(x509Cert, config)
+			}
+		}
+		globalSingleLintResult = result
+	})
+}
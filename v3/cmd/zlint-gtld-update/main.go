@@ -26,6 +26,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -110,6 +111,21 @@ var tldMap = map[string]GTLDPeriod{
 }
 `))
 
+	// onionPeriod is the entry added to the gTLD snapshot for .onion, as it is
+	// to the gTLD map by gTLDMapTemplate.
+	onionPeriod = util.GTLDPeriod{
+		GTLD:           "onion",
+		DelegationDate: "2015-02-18",
+	}
+
+	// gTLDJSONFile and tldsFile are the paths to local copies of the
+	// ICANN_GTLD_JSON and ICANN_TLDS data to use instead of fetching them.
+	gTLDJSONFile = ""
+	tldsFile     = ""
+
+	// writeJSON selects writing a util.GTLDSnapshot instead of Golang source.
+	writeJSON = false
+
 	printVersion = false
 )
 
@@ -147,14 +163,23 @@ func getData(url string) ([]byte, error) {
 	return respBody, nil
 }
 
-// getTLDData fetches the ICANN_TLDS list and uses the information to build
+// readData reads the file at path if it is set, and otherwise fetches the
+// response body bytes from an HTTP get to the provider url.
+func readData(path, url string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	return getData(url)
+}
+
+// getTLDData reads or fetches the ICANN_TLDS list and uses the information to build
 // and return a list of util.GTLDPeriod objects (or an error if anything fails).
 // Since this data source only contains TLD names and not any information
 // about delegation/removal all of the returned `util.GTLDPeriod` objects will
 // have the DelegationDate "1985-01-01" (matching the `.com` delegation date)
 // and no RemovalDate.
 func getTLDData() ([]util.GTLDPeriod, error) {
-	respBody, err := getData(ICANN_TLDS)
+	respBody, err := readData(tldsFile, ICANN_TLDS)
 	if err != nil {
 		return nil, fmt.Errorf("error getting ICANN TLD list : %s", err)
 	}
@@ -176,11 +201,11 @@ func getTLDData() ([]util.GTLDPeriod, error) {
 	return results, nil
 }
 
-// getGTLDData fetches the ICANN_GTLD_JSON and parses it into a list of
+// getGTLDData reads or fetches the ICANN_GTLD_JSON and parses it into a list of
 // util.GTLDPeriod objects, or returns an error. The gTLDEntries are returned
 // as-is and may contain entries that were never delegated from the root DNS.
 func getGTLDData() ([]util.GTLDPeriod, error) {
-	respBody, err := getData(ICANN_GTLD_JSON)
+	respBody, err := readData(gTLDJSONFile, ICANN_GTLD_JSON)
 	if err != nil {
 		return nil, fmt.Errorf("error getting ICANN gTLD JSON : %s", err)
 	}
@@ -190,9 +215,13 @@ func getGTLDData() ([]util.GTLDPeriod, error) {
 	}
 	//nolint:musttag
 	if err := json.Unmarshal(respBody, &results); err != nil {
+		source := ICANN_GTLD_JSON
+		if gTLDJSONFile != "" {
+			source = gTLDJSONFile
+		}
 		return nil, fmt.Errorf("unexpected error unmarshaling ICANN gTLD JSON response "+
 			"body from %q : %s",
-			ICANN_GTLD_JSON, err)
+			source, err)
 	}
 	return results.GTLDs, nil
 }
@@ -229,20 +258,17 @@ func validateGTLDs(entries []util.GTLDPeriod) error {
 	return nil
 }
 
-// renderGTLDMap fetches the ICANN gTLD data, filters out undelegated entries,
-// validates the remaining entries have parseable dates, and renders the
-// gTLDMapTemplate to the provided writer using the validated entries (or
-// returns an error if any of the aforementioned steps fail). It then fetches
-// the ICANN TLD data, and uses it to populate any missing entries for ccTLDs.
-// These entries will have a default delegationDate because the data source is
-// not specific enough to provide one. The produced output text is a Golang
-// source code file in the `util` package that contains a single map variable
-// containing GTLDPeriod objects created with the ICANN data.
-func renderGTLDMap(writer io.Writer) error {
+// buildGTLDMap gets the ICANN gTLD data, filters out undelegated entries,
+// validates the remaining entries have parseable dates, and returns them keyed
+// by gTLD (or returns an error if any of the aforementioned steps fail). It
+// then gets the ICANN TLD data, and uses it to populate any missing entries for
+// ccTLDs. These entries will have a default delegationDate because the data
+// source is not specific enough to provide one.
+func buildGTLDMap() (map[string]util.GTLDPeriod, error) {
 	// Get all of ICANN's gTLDs including ones that haven't been delegated.
 	allGTLDs, err := getGTLDData()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Filter out the non-delegated gTLD entries
@@ -250,7 +276,7 @@ func renderGTLDMap(writer io.Writer) error {
 
 	// Validate that all of the delegated gTLDs have correct dates
 	if err := validateGTLDs(delegatedGTLDs); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Get all of the TLDs. This data source doesn't provide delegationDates and
@@ -259,7 +285,7 @@ func renderGTLDMap(writer io.Writer) error {
 	// about the validity period for the TLD.
 	allTLDs, err := getTLDData()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tldMap := make(map[string]util.GTLDPeriod)
@@ -275,6 +301,19 @@ func renderGTLDMap(writer io.Writer) error {
 			tldMap[tld.GTLD] = tld
 		}
 	}
+	return tldMap, nil
+}
+
+// renderGTLDMap builds the gTLD map and renders the gTLDMapTemplate to the
+// provided writer using its entries (or returns an error if either step
+// fails). The produced output text is a Golang source code file in the `util`
+// package that contains a single map variable containing GTLDPeriod objects
+// created with the ICANN data.
+func renderGTLDMap(writer io.Writer) error {
+	tldMap, err := buildGTLDMap()
+	if err != nil {
+		return err
+	}
 
 	templateData := struct {
 		GTLDs map[string]util.GTLDPeriod
@@ -302,6 +341,30 @@ func renderGTLDMap(writer io.Writer) error {
 	return nil
 }
 
+// renderGTLDSnapshot builds the gTLD map and writes it to the provided writer
+// as a JSON encoded util.GTLDSnapshot, sorted by gTLD, which can be loaded at
+// runtime by util.ReadTLDMap in place of the gTLD map built into ZLint.
+func renderGTLDSnapshot(writer io.Writer) error {
+	tldMap, err := buildGTLDMap()
+	if err != nil {
+		return err
+	}
+	tldMap[onionPeriod.GTLD] = onionPeriod
+
+	var snapshot util.GTLDSnapshot
+	for _, tld := range tldMap {
+		snapshot.GTLDs = append(snapshot.GTLDs, tld)
+	}
+	sort.Slice(snapshot.GTLDs, func(i, j int) bool {
+		return snapshot.GTLDs[i].GTLD < snapshot.GTLDs[j].GTLD
+	})
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	//nolint:musttag
+	return encoder.Encode(snapshot)
+}
+
 // init sets up command line flags
 func init() {
 	flag.Usage = func() {
@@ -310,6 +373,9 @@ func init() {
 		flag.PrintDefaults()
 	}
 	flag.BoolVar(&printVersion, "version", false, "Print ZLint version and exit")
+	flag.StringVar(&gTLDJSONFile, "gtld-json", "", "Path to a local copy of the ICANN gTLD JSON registry to use instead of fetching "+ICANN_GTLD_JSON)
+	flag.StringVar(&tldsFile, "tlds", "", "Path to a local copy of the IANA list of top-level domains to use instead of fetching "+ICANN_TLDS)
+	flag.BoolVar(&writeJSON, "json", false, "Write a gTLD snapshot that can be loaded with the GTLDSnapshot configuration instead of Golang source")
 	flag.Parse()
 	log.SetLevel(log.InfoLevel)
 }
@@ -343,7 +409,11 @@ func main() {
 		writer = f
 	}
 
-	if err := renderGTLDMap(writer); err != nil {
+	render := renderGTLDMap
+	if writeJSON {
+		render = renderGTLDSnapshot
+	}
+	if err := render(writer); err != nil {
 		errQuit(err)
 	}
 }
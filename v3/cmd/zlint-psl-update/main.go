@@ -0,0 +1,230 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v3/util"
+)
+
+//nolint:revive
+const (
+	// PSL_URL is the URL of the Public Suffix List. It holds both the ICANN and
+	// the private sections of the list.
+	// See https://publicsuffix.org/list/ for more information.
+	PSL_URL = "https://publicsuffix.org/list/public_suffix_list.dat"
+)
+
+var (
+	// version is replaced by GoReleaser or `make` using an LDFlags option at
+	// build time. Here we supply a default value for folks that `go install` or
+	// `go build` directly from src.
+	version = "dev-unknown"
+
+	// httpClient is a http.Client instance configured with timeouts.
+	httpClient = &http.Client{
+		Transport: &http.Transport{
+			Dial: (&net.Dialer{
+				Timeout:   15 * time.Second,
+				KeepAlive: 15 * time.Second,
+			}).Dial,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 5 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+	// pslRulesTemplate is a template that produces a Golang source code file in
+	// the "util" package containing two member variables, the rules of the
+	// ICANN section of the Public Suffix List called `icannPublicSuffixRules`
+	// and the rules of the private section called `privatePublicSuffixRules`.
+	pslRulesTemplate = template.Must(template.New("pslRulesTemplate").Parse(
+		`// Code generated by go generate; DO NOT EDIT.
+// This file was generated by zlint-psl-update.
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+var icannPublicSuffixRules = []string{
+{{- range .ICANN }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+
+var privatePublicSuffixRules = []string{
+{{- range .Private }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+`))
+
+	// pslFile is the path to a local copy of the Public Suffix List to use
+	// instead of fetching PSL_URL.
+	pslFile = ""
+
+	printVersion = false
+)
+
+// getData fetches the response body bytes from an HTTP get to the provider url,
+// or returns an error.
+func getData(url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch data from %q : %s",
+			url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code fetching data "+
+			"from %q : expected status %d got %d",
+			url, http.StatusOK, resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error reading response "+
+			"body from %q : %s",
+			url, err)
+	}
+	return respBody, nil
+}
+
+// getPSL reads the Public Suffix List from pslFile if it is set, and otherwise
+// fetches it from PSL_URL.
+func getPSL() (*util.PublicSuffixList, error) {
+	if pslFile != "" {
+		return util.ReadPublicSuffixList(pslFile)
+	}
+	respBody, err := getData(PSL_URL)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the Public Suffix List : %s", err)
+	}
+	return util.ParsePublicSuffixList(bytes.NewReader(respBody))
+}
+
+// renderPSLRules reads the Public Suffix List and renders the pslRulesTemplate
+// to the provided writer using the rules of its ICANN and private sections (or
+// returns an error if either step fails). The produced output text is a Golang
+// source code file in the `util` package holding the rules that
+// util.DefaultPublicSuffixList is built from.
+func renderPSLRules(writer io.Writer) error {
+	psl, err := getPSL()
+	if err != nil {
+		return err
+	}
+
+	templateData := struct {
+		ICANN   []string
+		Private []string
+	}{
+		ICANN:   psl.ICANNRules(),
+		Private: psl.PrivateRules(),
+	}
+
+	var buf bytes.Buffer
+	if err := pslRulesTemplate.Execute(&buf, templateData); err != nil {
+		return err
+	}
+
+	// format the buffer so it won't trip up the `gofmt_test.go` checks
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(formatted)
+	return err
+}
+
+// init sets up command line flags
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "ZLint version %s\n\n", version)
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] [output file]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.BoolVar(&printVersion, "version", false, "Print ZLint version and exit")
+	flag.StringVar(&pslFile, "file", "", "Path to a local copy of the Public Suffix List to use instead of fetching "+PSL_URL)
+	flag.Parse()
+	log.SetLevel(log.InfoLevel)
+}
+
+// main handles rendering the Public Suffix List rules to either standard out
+// (when no argument is provided) or to the provided filename. If an error
+// occurs it is printed to standard err and the program terminates with a
+// non-zero exit status.
+func main() {
+	errQuit := func(err error) {
+		fmt.Fprintf(os.Stderr, "error updating Public Suffix List: %s\n", err)
+		os.Exit(1)
+	}
+
+	if printVersion {
+		fmt.Printf("ZLint version %s\n", version)
+		return
+	}
+
+	// Default to writing to standard out
+	writer := os.Stdout
+	if flag.NArg() > 0 {
+		// Render to a buffer first, so that the existing file is not truncated
+		// if the list cannot be read.
+		var buf bytes.Buffer
+		if err := renderPSLRules(&buf); err != nil {
+			errQuit(err)
+		}
+		if err := os.WriteFile(flag.Args()[0], buf.Bytes(), 0664); err != nil {
+			errQuit(err)
+		}
+		return
+	}
+
+	if err := renderPSLRules(writer); err != nil {
+		errQuit(err)
+	}
+}
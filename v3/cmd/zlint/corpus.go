@@ -0,0 +1,115 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+)
+
+// analyzeCorpus runs `zlint corpus`, which reads every certificate in the given
+// directories and reports the weaknesses of their keys that only show up across
+// certificates, as a JSON object of results keyed by certificate fingerprint.
+// It exits with status 1 if any are found. args are the arguments that follow
+// "corpus" on the command line.
+func analyzeCorpus(args []string) {
+	flags := flag.NewFlagSet("corpus", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] corpus directory...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Finds RSA moduli that share a prime, using batch GCD, and keys shared by CA and subscriber certificates\n")
+		fmt.Fprintf(os.Stderr, "among the PEM or DER encoded certificates in the given directories and their subdirectories.\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	analysis := zlint.NewCorpusAnalysis()
+	for _, dir := range flags.Args() {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			for _, c := range readCertificates(path) {
+				analysis.Add(c)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatalf("unable to read %s: %s", dir, err)
+		}
+	}
+	log.Infof("analyzing %d certificates", analysis.Len())
+
+	results := analysis.Analyze()
+	enc := json.NewEncoder(os.Stdout)
+	if prettyprint {
+		enc.SetIndent("", " ")
+	}
+	if err := enc.Encode(results); err != nil {
+		log.Fatalf("unable to encode results JSON: %s", err)
+	}
+	if len(results) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readCertificates returns the certificates in the file at path, which holds
+// either any number of PEM encoded certificates or a single DER encoded
+// certificate. Files that hold no certificate are skipped with a warning.
+func readCertificates(path string) []*x509.Certificate {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("unable to read %s: %s", path, err)
+	}
+	var certs []*x509.Certificate
+	rest := data
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			log.Warnf("skipping a certificate of %s that can not be parsed: %s", path, err)
+			continue
+		}
+		certs = append(certs, c)
+	}
+	if len(certs) > 0 || len(rest) < len(data) {
+		return certs
+	}
+	c, err := x509.ParseCertificate(data)
+	if err != nil {
+		log.Warnf("skipping %s, which is not a PEM or DER encoded certificate", path)
+		return nil
+	}
+	return []*x509.Certificate{c}
+}
@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -53,6 +52,20 @@ var ( // flags
 	printVersion    bool
 	config          string
 	exampleConfig   bool
+	validateConfig  bool
+	explain         bool
+	aggregate       string
+	aggregateTop    int
+	profileLints    bool
+
+	// aggregateReport accumulates the results of every input when -aggregate
+	// is in use.
+	aggregateReport *formattedoutput.AggregateReport
+	// lintTimings records the time taken by each lint when -profileLints is
+	// in use.
+	lintTimings *lint.TimingObserver
+	// lintOptions alter how the lints are executed against every input.
+	lintOptions []zlint.Option
 
 	// version is replaced by GoReleaser or `make` using an LDFlags option at
 	// build time. Here we supply a default value for folks that `go install` or
@@ -75,12 +88,21 @@ func init() {
 	flag.StringVar(&profile, "profile", "", "Name of the linting profile to use. Only the lints falling under this profile will be ran. For a list of lints per-profile, please see '-list-profiles'")
 	flag.BoolVar(&printVersion, "version", false, "Print ZLint version and exit")
 	flag.StringVar(&config, "config", "", "A path to valid a TOML file that is to service as the configuration for a single run of ZLint. Providing a configuration file allows for modifying the behavior of select lints. For an example configuration, please see '-exampleConfig'")
+	flag.BoolVar(&validateConfig, "validateConfig", false, "Checks the configuration provided by '-config' against the registered lints, prints any sections, keys or values that would be ignored or can not be applied, and exits. The exit status is 1 if any problems are found")
 	flag.BoolVar(&exampleConfig, "exampleConfig", false, "Prints a complete example of a configuration that is usable via the '-config' flag and exit. All values listed in this example will be set to their default.")
 
+	flag.BoolVar(&explain, "explain", false, "Prints an annotated rendering of each certificate's structure, with each lint finding shown next to the field it concerns, in place of the default JSON report. Only certificates that can be parsed are supported, and it can not be combined with -aggregate")
+	flag.StringVar(&aggregate, "aggregate", "", "Prints a single report aggregating the results of all inputs, counted per lint, issuer and lint source, in place of the per-input reports. One of {text, json, csv}")
+	flag.IntVar(&aggregateTop, "aggregateTop", 10, "The number of top failing lints listed in an '-aggregate' report. A value of 0 lists every lint that produced a finding")
+	flag.BoolVar(&profileLints, "profileLints", false, "Prints a table of the time taken by each lint across all inputs to stderr once linting has finished, slowest first")
+
 	flag.BoolVar(&prettyprint, "pretty", false, "Pretty-print JSON output")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "ZLint version %s\n\n", version)
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags] file...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] serve [serve flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] precert [precert flags] precertificate certificate\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [flags] corpus directory...\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 	flag.Parse()
@@ -106,6 +128,20 @@ func main() {
 		return
 	}
 
+	if validateConfig {
+		// The configuration is checked against every lint, rather than only
+		// those selected, so that sections for excluded lints are not
+		// reported.
+		problems := lint.GlobalRegistry().GetConfiguration().Validate(lint.GlobalRegistry())
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		if len(problems) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
 	if exampleConfig {
 		b, err := registry.DefaultConfiguration()
 		if err != nil {
@@ -136,6 +172,37 @@ func main() {
 		return
 	}
 
+	if flag.Arg(0) == "serve" {
+		serve(registry, flag.Args()[1:])
+		return
+	}
+
+	if flag.Arg(0) == "precert" {
+		comparePrecert(flag.Args()[1:])
+		return
+	}
+
+	if flag.Arg(0) == "corpus" {
+		analyzeCorpus(flag.Args()[1:])
+		return
+	}
+
+	switch aggregate {
+	case "":
+	case "text", "json", "csv":
+		aggregateReport = formattedoutput.NewAggregateReport(formattedoutput.DefaultMaxExamples)
+	default:
+		log.Fatalf("unknown -aggregate format %s", aggregate)
+	}
+	if explain && aggregateReport != nil {
+		log.Fatalf("-explain can not be combined with -aggregate")
+	}
+
+	if profileLints {
+		lintTimings = lint.NewTimingObserver()
+		lintOptions = append(lintOptions, zlint.WithObserver(lintTimings))
+	}
+
 	var inform = strings.ToLower(format)
 	if flag.NArg() < 1 || flag.Arg(0) == "-" {
 		doLint(os.Stdin, inform, registry)
@@ -159,6 +226,32 @@ func main() {
 			inputFile.Close()
 		}
 	}
+
+	if aggregateReport != nil {
+		writeAggregate()
+	}
+	if lintTimings != nil {
+		if err := lintTimings.WriteProfile(os.Stderr); err != nil {
+			log.Fatalf("unable to write lint profile: %s", err)
+		}
+	}
+}
+
+// writeAggregate writes the aggregate report of all inputs to stdout in the
+// format selected by -aggregate.
+func writeAggregate() {
+	var err error
+	switch aggregate {
+	case "text":
+		aggregateReport.WriteText(os.Stdout, aggregateTop)
+	case "json":
+		err = aggregateReport.WriteJSON(os.Stdout, aggregateTop)
+	case "csv":
+		err = aggregateReport.WriteCSV(os.Stdout)
+	}
+	if err != nil {
+		log.Fatalf("unable to write aggregate report: %s", err)
+	}
 }
 
 //nolint:cyclop
@@ -169,7 +262,7 @@ func doLint(inputFile *os.File, inform string, registry lint.Registry) {
 	}
 
 	var asn1Data []byte
-	var isCRL bool
+	var isCRL, isCSR bool
 	switch inform {
 	case "pem":
 		p, _ := pem.Decode(fileBytes)
@@ -180,6 +273,8 @@ func doLint(inputFile *os.File, inform string, registry lint.Registry) {
 		case "CERTIFICATE":
 		case "X509 CRL":
 			isCRL = true
+		case "CERTIFICATE REQUEST", "NEW CERTIFICATE REQUEST":
+			isCSR = true
 		default:
 			log.Fatalf("unknown PEM type (%s)", p.Type)
 		}
@@ -194,19 +289,55 @@ func doLint(inputFile *os.File, inform string, registry lint.Registry) {
 	default:
 		log.Fatalf("unknown input format %s", format)
 	}
+	if explain && (isCSR || isCRL) {
+		log.Fatalf("-explain only supports certificates, %s is not a certificate", inputFile.Name())
+	}
 	var zlintResult *zlint.ResultSet
-	if isCRL {
-		crl, err := x509.ParseRevocationList(asn1Data)
-		if err != nil {
-			log.Fatalf("unable to parse certificate revocation list: %s", err)
+	if isCSR {
+		// Certificate signing requests that can not be parsed are reported as
+		// a fatal e_csr_unparseable result.
+		zlintResult = zlint.LintRawCertificateRequestEx(asn1Data, registry, lintOptions...)
+		if aggregateReport != nil {
+			aggregateReport.AddRawInput(asn1Data, zlintResult)
+			return
+		}
+	} else if isCRL {
+		// CRLs that can not be parsed are reported as a fatal
+		// e_crl_unparseable result.
+		zlintResult = zlint.LintRawRevocationListEx(asn1Data, registry, lintOptions...)
+		if aggregateReport != nil {
+			if crl, err := x509.ParseRevocationList(asn1Data); err == nil {
+				aggregateReport.AddRevocationList(crl, zlintResult)
+			} else {
+				aggregateReport.AddRawInput(asn1Data, zlintResult)
+			}
+			return
 		}
-		zlintResult = zlint.LintRevocationListEx(crl, registry)
 	} else {
 		c, err := x509.ParseCertificate(asn1Data)
+		if err != nil && explain {
+			log.Fatalf("-explain requires a parseable certificate, unable to parse %s: %s", inputFile.Name(), err)
+		}
 		if err != nil {
-			log.Fatalf("unable to parse certificate: %s", err)
+			// Lint the raw DER of certificates that can not be parsed, which
+			// reports the parsing error alongside any other findings.
+			zlintResult = zlint.LintRawCertificateEx(asn1Data, registry, lintOptions...)
+		} else {
+			zlintResult = zlint.LintCertificateEx(c, registry, lintOptions...)
+		}
+		if aggregateReport != nil {
+			if c == nil {
+				aggregateReport.AddRawInput(asn1Data, zlintResult)
+			} else {
+				aggregateReport.AddCertificate(c, zlintResult)
+			}
+			return
+		}
+		if explain {
+			formattedoutput.ExplainCertificate(os.Stdout, c, zlintResult)
+			os.Stdout.Sync()
+			return
 		}
-		zlintResult = zlint.LintCertificateEx(c, registry)
 	}
 	jsonBytes, err := json.Marshal(zlintResult.Results)
 	if err != nil {
@@ -233,20 +364,11 @@ func doLint(inputFile *os.File, inform string, registry lint.Registry) {
 	os.Stdout.Sync()
 }
 
-// trimmedList takes a comma separated string argument in raw, splits it by
-// comma, and returns a list of the separated elements after trimming spaces
-// from each element.
-func trimmedList(raw string) []string {
-	var list []string
-	for _, item := range strings.Split(raw, ",") {
-		list = append(list, strings.TrimSpace(item))
-	}
-	return list
-}
-
-// setLints returns a filtered registry to use based on the nameFilter,
-// includeNames, excludeNames, includeSources, and excludeSources flag values in
-// use.
+// setLints returns a filtered registry to use based on the [Filter] section of
+// the configuration, on top of which the nameFilter, includeNames,
+// excludeNames, includeSources, excludeSources and profile flag values in use
+// are layered. Each flag that is set replaces the corresponding setting of
+// the configuration.
 //
 //nolint:cyclop
 func setLints() (lint.Registry, error) {
@@ -255,49 +377,23 @@ func setLints() (lint.Registry, error) {
 		return nil, err
 	}
 	lint.GlobalRegistry().SetConfiguration(configuration)
-	// If there's no filter options set, use the global registry as-is
-	anyFilters := func(args ...string) bool {
-		for _, arg := range args {
-			if arg != "" {
-				return true
-			}
-		}
-		return false
-	}
-	if !anyFilters(nameFilter, includeNames, excludeNames, includeSources, excludeSources, profile) {
-		return lint.GlobalRegistry(), nil
-	}
-	filterOpts := lint.FilterOptions{}
-	if nameFilter != "" {
-		r, err := regexp.Compile(nameFilter)
-		if err != nil {
-			return nil, fmt.Errorf("bad -nameFilter: %v", err)
-		}
-		filterOpts.NameFilter = r
-	}
-	if excludeSources != "" {
-		if err := filterOpts.ExcludeSources.FromString(excludeSources); err != nil {
-			log.Fatalf("invalid -excludeSources: %v", err)
-		}
-	}
-	if includeSources != "" {
-		if err := filterOpts.IncludeSources.FromString(includeSources); err != nil {
-			log.Fatalf("invalid -includeSources: %v\n", err)
-		}
-	}
-	if excludeNames != "" {
-		filterOpts.ExcludeNames = trimmedList(excludeNames)
-	}
-	if includeNames != "" {
-		filterOpts.IncludeNames = trimmedList(includeNames)
+	filter, err := configuration.FilterConfig()
+	if err != nil {
+		return nil, err
 	}
-	if profile != "" {
-		p, ok := lint.GetProfile(profile)
-		if !ok {
-			return nil, fmt.Errorf("lint profile name does not exist: %v", profile)
-		}
-		filterOpts.AddProfile(p)
+	filter = filter.Override(lint.FilterFlags{
+		NameFilter:     nameFilter,
+		IncludeNames:   includeNames,
+		ExcludeNames:   excludeNames,
+		IncludeSources: includeSources,
+		ExcludeSources: excludeSources,
+		Profile:        profile,
+	})
+	filterOpts, err := filter.Options()
+	if err != nil {
+		return nil, err
 	}
-
+	// If there's no filter options set, Filter returns the global registry
+	// as-is.
 	return lint.GlobalRegistry().Filter(filterOpts)
 }
@@ -0,0 +1,86 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+)
+
+// comparePrecert runs `zlint precert`, which reports every difference between
+// a precertificate and the certificate issued from it as a JSON array, and
+// exits with status 1 if there are any. args are the arguments that follow
+// "precert" on the command line.
+func comparePrecert(args []string) {
+	flags := flag.NewFlagSet("precert", flag.ExitOnError)
+	issuerFile := flags.String("issuer", "", "The precertificate signing certificate that issued the precertificate, if any")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] precert [precert flags] precertificate certificate\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Compares the TBSCertificate of a precertificate with that of the certificate issued from it.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	precert := readCertificateFile(flags.Arg(0))
+	cert := readCertificateFile(flags.Arg(1))
+	var precertIssuer *x509.Certificate
+	if *issuerFile != "" {
+		precertIssuer = readCertificateFile(*issuerFile)
+	}
+	diffs, err := zlint.ComparePrecertificate(precert, cert, precertIssuer)
+	if err != nil {
+		log.Fatalf("unable to compare %s with %s: %s", flags.Arg(0), flags.Arg(1), err)
+	}
+	if diffs == nil {
+		diffs = []zlint.PrecertificateDifference{}
+	}
+	enc := json.NewEncoder(os.Stdout)
+	if prettyprint {
+		enc.SetIndent("", " ")
+	}
+	if err := enc.Encode(diffs); err != nil {
+		log.Fatalf("unable to encode differences JSON: %s", err)
+	}
+	if len(diffs) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readCertificateFile reads the PEM or DER encoded certificate in path.
+func readCertificateFile(path string) *x509.Certificate {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("unable to read %s: %s", path, err)
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+	c, err := x509.ParseCertificate(data)
+	if err != nil {
+		log.Fatalf("unable to parse certificate %s: %s", path, err)
+	}
+	return c
+}
@@ -0,0 +1,74 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/server"
+)
+
+// serve runs the HTTP linting service of `zlint serve` with the lints of
+// registry until it receives SIGINT or SIGTERM, after which in-flight requests
+// are given shutdownTimeout to complete. args are the arguments that follow
+// "serve" on the command line.
+func serve(registry lint.Registry, args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "The address to listen on")
+	maxRequestBytes := flags.Int64("maxRequestBytes", server.DefaultMaxRequestBytes, "The largest request body, in bytes, that is accepted")
+	shutdownTimeout := flags.Duration("shutdownTimeout", 10*time.Second, "How long in-flight requests are given to complete once a shutdown is requested")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] serve [serve flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Serves a local HTTP JSON API for linting, using the lints selected by flags.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.NewHandler(registry, *maxRequestBytes),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	log.Infof("serving on %s", *addr)
+
+	select {
+	case err := <-errs:
+		log.Fatalf("unable to serve: %s", err)
+	case <-ctx.Done():
+	}
+	log.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("unable to shut down: %s", err)
+	}
+}
@@ -0,0 +1,223 @@
+package zlint
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// The names under which the findings of a CorpusAnalysis are reported in the
+// Results of a ResultSet.
+const (
+	// CorpusSharedPrime is reported for certificates whose RSA modulus shares
+	// a prime with the modulus of another certificate of the corpus, so that
+	// the private keys of both can be computed from their public keys.
+	CorpusSharedPrime = "e_rsa_modulus_shares_prime"
+	// CorpusKeyReuse is reported for CA certificates and subscriber
+	// certificates of the corpus that have the same subject public key.
+	CorpusKeyReuse = "e_ca_and_subscriber_share_key"
+)
+
+// CorpusAnalysis finds weaknesses of the keys of a corpus of certificates
+// that only show up across many certificates, and so can not be found by lints,
+// which see one certificate at a time. Certificates are added to the corpus
+// with Add and the corpus is then analyzed by Analyze.
+//
+// Only the public keys and fingerprints of the certificates are retained, but
+// the analysis of RSA moduli by batch GCD holds the product of every distinct
+// modulus in memory.
+type CorpusAnalysis struct {
+	certificates map[string]*corpusCertificate
+}
+
+// corpusCertificate holds what a CorpusAnalysis retains of a certificate.
+type corpusCertificate struct {
+	fingerprint string
+	ca          bool
+	subscriber  bool
+	spki        [sha256.Size]byte
+	modulus     *big.Int
+}
+
+// NewCorpusAnalysis returns an empty CorpusAnalysis.
+func NewCorpusAnalysis() *CorpusAnalysis {
+	return &CorpusAnalysis{certificates: make(map[string]*corpusCertificate)}
+}
+
+// Add adds c to the corpus. Adding a certificate that is already in the
+// corpus has no effect.
+func (a *CorpusAnalysis) Add(c *x509.Certificate) {
+	fingerprint := c.FingerprintSHA256.Hex()
+	if _, ok := a.certificates[fingerprint]; ok {
+		return
+	}
+	cert := &corpusCertificate{
+		fingerprint: fingerprint,
+		ca:          util.IsCACert(c),
+		subscriber:  util.IsSubscriberCert(c),
+		spki:        sha256.Sum256(c.RawSubjectPublicKeyInfo),
+	}
+	if key, ok := c.PublicKey.(*rsa.PublicKey); ok && key.N != nil {
+		cert.modulus = key.N
+	}
+	a.certificates[fingerprint] = cert
+}
+
+// Len returns the number of certificates in the corpus.
+func (a *CorpusAnalysis) Len() int {
+	return len(a.certificates)
+}
+
+// Analyze returns the findings for the certificates of the corpus in the same
+// form as the results of linting them, indexed by the hexadecimal SHA-256
+// fingerprint of each certificate. Only certificates with findings are
+// included, under the names CorpusSharedPrime and CorpusKeyReuse.
+func (a *CorpusAnalysis) Analyze() map[string]*ResultSet {
+	results := make(map[string]*ResultSet)
+	add := func(fingerprint, name string, result *lint.LintResult) {
+		res, ok := results[fingerprint]
+		if !ok {
+			res = &ResultSet{
+				Version:   Version,
+				Timestamp: time.Now().Unix(),
+				Results:   make(map[string]*lint.LintResult),
+			}
+			results[fingerprint] = res
+		}
+		res.Results[name] = result
+		res.updateErrorStatePresent(result)
+	}
+	for fingerprint, others := range a.sharedPrimes() {
+		add(fingerprint, CorpusSharedPrime, &lint.LintResult{
+			Status: lint.Error,
+			Details: fmt.Sprintf("The RSA modulus shares a prime with the moduli of the certificates %s, "+
+				"so the private key can be computed from the public key", strings.Join(others, ", ")),
+			Location: lint.AtField(lint.FieldSubjectPublicKeyInfo),
+		})
+	}
+	for fingerprint, reuse := range a.reusedKeys() {
+		add(fingerprint, CorpusKeyReuse, &lint.LintResult{
+			Status:   lint.Error,
+			Details:  reuse,
+			Location: lint.AtField(lint.FieldSubjectPublicKeyInfo),
+		})
+	}
+	return results
+}
+
+// sharedPrimes returns the fingerprints of the certificates whose RSA modulus
+// shares a prime with that of other certificates, each mapped to the sorted
+// fingerprints of those other certificates.
+func (a *CorpusAnalysis) sharedPrimes() map[string][]string {
+	// Certificates with the same key have the same modulus, which is not a
+	// shared prime, so each distinct modulus is analyzed once.
+	byModulus := make(map[string][]*corpusCertificate)
+	var moduli []*big.Int
+	for _, cert := range a.sortedCertificates() {
+		if cert.modulus == nil {
+			continue
+		}
+		key := string(cert.modulus.Bytes())
+		if _, ok := byModulus[key]; !ok {
+			moduli = append(moduli, cert.modulus)
+		}
+		byModulus[key] = append(byModulus[key], cert)
+	}
+	var weak []*big.Int
+	for i, gcd := range util.BatchGCD(moduli) {
+		if gcd.Cmp(big.NewInt(1)) != 0 {
+			weak = append(weak, moduli[i])
+		}
+	}
+	// The moduli that share primes are few, so those that share a prime with
+	// each other are paired up by comparing every pair.
+	shared := make(map[string][]string)
+	gcd := new(big.Int)
+	for i, n := range weak {
+		for j, m := range weak {
+			if i == j || gcd.GCD(nil, nil, n, m).Cmp(big.NewInt(1)) == 0 {
+				continue
+			}
+			for _, cert := range byModulus[string(n.Bytes())] {
+				for _, other := range byModulus[string(m.Bytes())] {
+					shared[cert.fingerprint] = append(shared[cert.fingerprint], other.fingerprint)
+				}
+			}
+		}
+	}
+	for _, others := range shared {
+		sort.Strings(others)
+	}
+	return shared
+}
+
+// reusedKeys returns the fingerprints of the CA and subscriber certificates
+// that have the same subject public key as a certificate of the other kind,
+// each mapped to a description of the reuse.
+func (a *CorpusAnalysis) reusedKeys() map[string]string {
+	bySPKI := make(map[[sha256.Size]byte][]*corpusCertificate)
+	for _, cert := range a.sortedCertificates() {
+		if cert.ca || cert.subscriber {
+			bySPKI[cert.spki] = append(bySPKI[cert.spki], cert)
+		}
+	}
+	reused := make(map[string]string)
+	for spki, certs := range bySPKI {
+		var cas, subscribers []string
+		for _, cert := range certs {
+			if cert.ca {
+				cas = append(cas, cert.fingerprint)
+			} else {
+				subscribers = append(subscribers, cert.fingerprint)
+			}
+		}
+		if len(cas) == 0 || len(subscribers) == 0 {
+			continue
+		}
+		for _, ca := range cas {
+			reused[ca] = fmt.Sprintf("The subject public key with SPKI SHA-256 hash %x of this CA certificate "+
+				"is also the key of the subscriber certificates %s", spki, strings.Join(subscribers, ", "))
+		}
+		for _, subscriber := range subscribers {
+			reused[subscriber] = fmt.Sprintf("The subject public key with SPKI SHA-256 hash %x of this subscriber certificate "+
+				"is also the key of the CA certificates %s", spki, strings.Join(cas, ", "))
+		}
+	}
+	return reused
+}
+
+// sortedCertificates returns the certificates of the corpus in order of their
+// fingerprints, so that the analysis does not depend on the order in which
+// they were added.
+func (a *CorpusAnalysis) sortedCertificates() []*corpusCertificate {
+	certs := make([]*corpusCertificate, 0, len(a.certificates))
+	for _, cert := range a.certificates {
+		certs = append(certs, cert)
+	}
+	sort.Slice(certs, func(i, j int) bool {
+		return certs[i].fingerprint < certs[j].fingerprint
+	})
+	return certs
+}
@@ -0,0 +1,83 @@
+package zlint
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func TestCorpusAnalysis(t *testing.T) {
+	certs := make(map[string]string)
+	analysis := NewCorpusAnalysis()
+	for _, name := range []string{
+		"corpusCA.pem",
+		"corpusSharedPrimeA.pem",
+		"corpusSharedPrimeB.pem",
+		"corpusKeyReuse.pem",
+		"corpusClean.pem",
+		// Added twice
+		"corpusClean.pem",
+	} {
+		c := readTestCertificate(t, name)
+		certs[name] = c.FingerprintSHA256.Hex()
+		analysis.Add(c)
+	}
+	if analysis.Len() != 5 {
+		t.Errorf("expected 5 certificates, got %d", analysis.Len())
+	}
+	results := analysis.Analyze()
+	expected := map[string]struct {
+		name  string
+		other string
+	}{
+		"corpusSharedPrimeA.pem": {CorpusSharedPrime, "corpusSharedPrimeB.pem"},
+		"corpusSharedPrimeB.pem": {CorpusSharedPrime, "corpusSharedPrimeA.pem"},
+		"corpusCA.pem":           {CorpusKeyReuse, "corpusKeyReuse.pem"},
+		"corpusKeyReuse.pem":     {CorpusKeyReuse, "corpusCA.pem"},
+	}
+	if len(results) != len(expected) {
+		t.Errorf("expected results for %d certificates, got %d", len(expected), len(results))
+	}
+	for file, want := range expected {
+		res, ok := results[certs[file]]
+		if !ok {
+			t.Errorf("%s: expected results", file)
+			continue
+		}
+		if len(res.Results) != 1 || !res.ErrorsPresent {
+			t.Errorf("%s: expected a single error, got %v", file, res.Results)
+		}
+		result, ok := res.Results[want.name]
+		if !ok {
+			t.Errorf("%s: expected %s", file, want.name)
+			continue
+		}
+		if result.Status != lint.Error {
+			t.Errorf("%s: expected %s, got %s", file, lint.Error, result.Status)
+		}
+		if !strings.Contains(result.Details, certs[want.other]) {
+			t.Errorf("%s: expected the details %q to name %s", file, result.Details, want.other)
+		}
+	}
+}
+
+func TestCorpusAnalysisEmpty(t *testing.T) {
+	if results := NewCorpusAnalysis().Analyze(); len(results) != 0 {
+		t.Errorf("expected no results, got %v", results)
+	}
+}
@@ -0,0 +1,424 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// DefaultMaxExamples is the number of example fingerprints retained per lint
+// by an AggregateReport constructed with a non-positive maxExamples.
+const DefaultMaxExamples = 5
+
+// StatusCount counts lint results by their LintStatus.
+type StatusCount struct {
+	NA     int `json:"NA,omitempty"`
+	NE     int `json:"NE,omitempty"`
+	Pass   int `json:"pass,omitempty"`
+	Notice int `json:"info,omitempty"`
+	Warn   int `json:"warn,omitempty"`
+	Error  int `json:"error,omitempty"`
+	Fatal  int `json:"fatal,omitempty"`
+}
+
+// Inc increases the count for the given lint status level.
+func (s *StatusCount) Inc(status lint.LintStatus) {
+	switch status {
+	case lint.NA:
+		s.NA++
+	case lint.NE:
+		s.NE++
+	case lint.Pass:
+		s.Pass++
+	case lint.Notice:
+		s.Notice++
+	case lint.Warn:
+		s.Warn++
+	case lint.Error:
+		s.Error++
+	case lint.Fatal:
+		s.Fatal++
+	}
+}
+
+// Findings returns the number of results that are above lint.Pass, which is
+// the same threshold used by OutputSummary.
+func (s StatusCount) Findings() int {
+	return s.Notice + s.Warn + s.Error + s.Fatal
+}
+
+// row returns the counts in ascending LintStatus order, suitable for use as
+// table or CSV columns.
+func (s StatusCount) row() []string {
+	return []string{
+		strconv.Itoa(s.NA),
+		strconv.Itoa(s.NE),
+		strconv.Itoa(s.Pass),
+		strconv.Itoa(s.Notice),
+		strconv.Itoa(s.Warn),
+		strconv.Itoa(s.Error),
+		strconv.Itoa(s.Fatal),
+	}
+}
+
+// statusHeadings are the column headings matching StatusCount.row.
+var statusHeadings = []string{
+	lint.NA.String(),
+	lint.NE.String(),
+	lint.Pass.String(),
+	lint.Notice.String(),
+	lint.Warn.String(),
+	lint.Error.String(),
+	lint.Fatal.String(),
+}
+
+// LintAggregate is the aggregated outcome of a single lint across every input
+// added to an AggregateReport.
+type LintAggregate struct {
+	Name   string          `json:"name"`
+	Source lint.LintSource `json:"source"`
+	Counts StatusCount     `json:"counts"`
+	// Examples holds up to AggregateReport.MaxExamples fingerprints of inputs
+	// for which this lint produced a result above lint.Pass.
+	Examples []string `json:"examples,omitempty"`
+}
+
+// IssuerAggregate is the aggregated outcome of all lints across every input
+// from a single issuer, identified by its DN and key identifier.
+type IssuerAggregate struct {
+	DN     string      `json:"dn"`
+	KeyID  string      `json:"key_id,omitempty"`
+	Inputs int         `json:"inputs"`
+	Counts StatusCount `json:"counts"`
+}
+
+// issuerKey identifies the IssuerAggregate an input is counted against.
+type issuerKey struct {
+	dn    string
+	keyID string
+}
+
+// SourceAggregate is the aggregated outcome of all lints sharing a LintSource
+// across every input.
+type SourceAggregate struct {
+	Source lint.LintSource `json:"source"`
+	Counts StatusCount     `json:"counts"`
+}
+
+// AggregateReport accumulates the ResultSets of many inputs into counts per
+// lint, per issuer and per LintSource. It is safe for concurrent use, which
+// allows corpus runs to add results from several linting goroutines.
+type AggregateReport struct {
+	// MaxExamples is the maximum number of example fingerprints kept per lint.
+	MaxExamples int
+
+	mu      sync.Mutex
+	inputs  int
+	lints   map[string]*LintAggregate
+	issuers map[issuerKey]*IssuerAggregate
+	sources map[lint.LintSource]*SourceAggregate
+}
+
+// NewAggregateReport returns an empty AggregateReport keeping up to
+// maxExamples fingerprints per lint. If maxExamples is not positive then
+// DefaultMaxExamples is used.
+func NewAggregateReport(maxExamples int) *AggregateReport {
+	if maxExamples <= 0 {
+		maxExamples = DefaultMaxExamples
+	}
+	return &AggregateReport{
+		MaxExamples: maxExamples,
+		lints:       make(map[string]*LintAggregate),
+		issuers:     make(map[issuerKey]*IssuerAggregate),
+		sources:     make(map[lint.LintSource]*SourceAggregate),
+	}
+}
+
+// AddCertificate adds the results of linting c to the report. The certificate
+// is identified by its SHA-256 fingerprint and attributed to its issuer DN and
+// authority key identifier.
+func (a *AggregateReport) AddCertificate(c *x509.Certificate, results *zlint.ResultSet) {
+	a.Add(c.FingerprintSHA256.Hex(), c.Issuer.String(), hex.EncodeToString(c.AuthorityKeyId), results)
+}
+
+// AddRevocationList adds the results of linting r to the report. The CRL is
+// identified by the SHA-256 hash of its DER and attributed to its issuer DN and
+// authority key identifier.
+func (a *AggregateReport) AddRevocationList(r *x509.RevocationList, results *zlint.ResultSet) {
+	sum := sha256.Sum256(r.Raw)
+	a.Add(hex.EncodeToString(sum[:]), r.Issuer.String(), hex.EncodeToString(r.AuthorityKeyId), results)
+}
+
+// AddRawInput adds the results of linting the DER encoded input der, such as
+// a certificate or CRL which could not be parsed or a certificate signing
+// request, to the report. The input is identified by the SHA-256 hash of der
+// and, as it has no known issuer, counted against an issuer with an empty DN.
+func (a *AggregateReport) AddRawInput(der []byte, results *zlint.ResultSet) {
+	sum := sha256.Sum256(der)
+	a.Add(hex.EncodeToString(sum[:]), "", "", results)
+}
+
+// Add adds the results of linting a single input to the report. The
+// fingerprint is recorded as an example for lints that reported a finding, and
+// issuerDN and issuerKeyID together identify the issuer bucket the results are
+// counted against.
+func (a *AggregateReport) Add(fingerprint, issuerDN, issuerKeyID string, results *zlint.ResultSet) {
+	if results == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inputs++
+	key := issuerKey{dn: issuerDN, keyID: issuerKeyID}
+	issuer, ok := a.issuers[key]
+	if !ok {
+		issuer = &IssuerAggregate{DN: issuerDN, KeyID: issuerKeyID}
+		a.issuers[key] = issuer
+	}
+	issuer.Inputs++
+
+	for name, result := range results.Results {
+		l, ok := a.lints[name]
+		if !ok {
+			l = &LintAggregate{Name: name, Source: result.LintMetadata.Source}
+			a.lints[name] = l
+		}
+		l.Counts.Inc(result.Status)
+		if result.Status > lint.Pass && len(l.Examples) < a.MaxExamples {
+			l.Examples = append(l.Examples, fingerprint)
+		}
+
+		source, ok := a.sources[l.Source]
+		if !ok {
+			source = &SourceAggregate{Source: l.Source}
+			a.sources[l.Source] = source
+		}
+		source.Counts.Inc(result.Status)
+
+		issuer.Counts.Inc(result.Status)
+	}
+}
+
+// Inputs returns the number of inputs that have been added to the report.
+func (a *AggregateReport) Inputs() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.inputs
+}
+
+// Lints returns the per-lint aggregates sorted by lint name.
+func (a *AggregateReport) Lints() []LintAggregate {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	lints := make([]LintAggregate, 0, len(a.lints))
+	for _, l := range a.lints {
+		c := *l
+		c.Examples = append([]string(nil), l.Examples...)
+		lints = append(lints, c)
+	}
+	sort.Slice(lints, func(i, j int) bool {
+		return lints[i].Name < lints[j].Name
+	})
+	return lints
+}
+
+// Issuers returns the per-issuer aggregates sorted by DN and then key ID.
+func (a *AggregateReport) Issuers() []IssuerAggregate {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	issuers := make([]IssuerAggregate, 0, len(a.issuers))
+	for _, i := range a.issuers {
+		issuers = append(issuers, *i)
+	}
+	sort.Slice(issuers, func(i, j int) bool {
+		if issuers[i].DN != issuers[j].DN {
+			return issuers[i].DN < issuers[j].DN
+		}
+		return issuers[i].KeyID < issuers[j].KeyID
+	})
+	return issuers
+}
+
+// Sources returns the per-LintSource aggregates sorted by source.
+func (a *AggregateReport) Sources() []SourceAggregate {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	sources := make([]SourceAggregate, 0, len(a.sources))
+	for _, s := range a.sources {
+		sources = append(sources, *s)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Source < sources[j].Source
+	})
+	return sources
+}
+
+// TopFailing returns up to n lints with the most findings (results above
+// lint.Pass), ordered by the number of findings and then by name. Lints
+// without any findings are never returned. If n is not positive then every
+// lint with findings is returned.
+func (a *AggregateReport) TopFailing(n int) []LintAggregate {
+	var failing []LintAggregate
+	for _, l := range a.Lints() {
+		if l.Counts.Findings() > 0 {
+			failing = append(failing, l)
+		}
+	}
+	sort.SliceStable(failing, func(i, j int) bool {
+		return failing[i].Counts.Findings() > failing[j].Counts.Findings()
+	})
+	if n > 0 && len(failing) > n {
+		failing = failing[:n]
+	}
+	return failing
+}
+
+// aggregateJSON is the serialized form of an AggregateReport.
+type aggregateJSON struct {
+	Inputs     int               `json:"inputs"`
+	Lints      []LintAggregate   `json:"lints"`
+	Issuers    []IssuerAggregate `json:"issuers"`
+	Sources    []SourceAggregate `json:"sources"`
+	TopFailing []string          `json:"top_failing"`
+}
+
+// WriteJSON writes the report as a single JSON object to w. The top failing
+// lints are listed by name, limited to the top n as described by TopFailing.
+func (a *AggregateReport) WriteJSON(w io.Writer, n int) error {
+	out := aggregateJSON{
+		Inputs:     a.Inputs(),
+		Lints:      a.Lints(),
+		Issuers:    a.Issuers(),
+		Sources:    a.Sources(),
+		TopFailing: []string{},
+	}
+	for _, l := range a.TopFailing(n) {
+		out.TopFailing = append(out.TopFailing, l.Name)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(out)
+}
+
+// WriteCSV writes the report to w as CSV. Each record describes either a lint,
+// an issuer or a source (given by the first "scope" column) followed by the
+// status counts. Lint records carry their example fingerprints, separated by
+// semicolons, in the final column.
+//
+// Header:
+//
+//	scope, key, key_id, inputs, NA, NE, pass, info, warn, error, fatal, examples
+func (a *AggregateReport) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	header := append([]string{"scope", "key", "key_id", "inputs"}, statusHeadings...)
+	header = append(header, "examples")
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	inputs := strconv.Itoa(a.Inputs())
+	for _, l := range a.Lints() {
+		record := append([]string{"lint", l.Name, "", inputs}, l.Counts.row()...)
+		record = append(record, strings.Join(l.Examples, ";"))
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, i := range a.Issuers() {
+		record := append([]string{"issuer", i.DN, i.KeyID, strconv.Itoa(i.Inputs)}, i.Counts.row()...)
+		record = append(record, "")
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, s := range a.Sources() {
+		record := append([]string{"source", string(s.Source), "", inputs}, s.Counts.row()...)
+		record = append(record, "")
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// WriteText writes a tabular, human-readable report to w. Only lints with
+// findings are listed in the per-lint table, and the top n failing lints are
+// listed together with their example fingerprints.
+func (a *AggregateReport) WriteText(w io.Writer, n int) {
+	fmt.Fprintf(w, "Inputs linted: %d\n\n", a.Inputs())
+
+	var lines [][]string
+	for _, l := range a.TopFailing(0) {
+		lines = append(lines, append([]string{l.Name}, l.Counts.row()...))
+	}
+	printCountsTable(w, "Lint", lines)
+
+	lines = nil
+	for _, i := range a.Issuers() {
+		lines = append(lines, append([]string{i.DN, i.KeyID, strconv.Itoa(i.Inputs)}, i.Counts.row()...))
+	}
+	printCountsTable(w, "Issuer DN", lines, "Key ID", "Inputs")
+
+	lines = nil
+	for _, s := range a.Sources() {
+		lines = append(lines, append([]string{string(s.Source)}, s.Counts.row()...))
+	}
+	printCountsTable(w, "Source", lines)
+
+	lines = nil
+	for _, l := range a.TopFailing(n) {
+		examples := l.Examples
+		if len(examples) == 0 {
+			examples = []string{" - "}
+		}
+		for i, example := range examples {
+			if i == 0 {
+				lines = append(lines, []string{l.Name, strconv.Itoa(l.Counts.Findings()), example})
+			} else {
+				lines = append(lines, []string{"", "", example})
+			}
+		}
+	}
+	printPaddedTable(w, []string{"Top failing lint", "# findings", "Example fingerprints"}, lines)
+}
+
+// printCountsTable prints a table whose leading columns are given by key and
+// extra, followed by one column per LintStatus.
+func printCountsTable(w io.Writer, key string, lines [][]string, extra ...string) {
+	headings := append([]string{key}, extra...)
+	headings = append(headings, statusHeadings...)
+	printPaddedTable(w, headings, lines)
+}
+
+// printPaddedTable prints a table in the same style as OutputSummary, with
+// each heading padded to the width of the longest value in its column.
+func printPaddedTable(w io.Writer, headings []string, lines [][]string) {
+	printTableBody(w, printTableHeadings(w, padTableHeadings(headings, lines)), lines)
+	fmt.Fprintf(w, "\n")
+}
@@ -0,0 +1,135 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func resultSet(results map[string]lint.LintStatus) *zlint.ResultSet {
+	rs := &zlint.ResultSet{Results: map[string]*lint.LintResult{}}
+	for name, status := range results {
+		source := lint.RFC5280
+		if name[0] == 'w' {
+			source = lint.Community
+		}
+		rs.Results[name] = &lint.LintResult{
+			Status:       status,
+			LintMetadata: lint.LintMetadata{Name: name, Source: source},
+		}
+	}
+	return rs
+}
+
+func TestAggregateReport(t *testing.T) {
+	report := NewAggregateReport(2)
+	report.Add("aa", "CN=Issuer A", "01", resultSet(map[string]lint.LintStatus{
+		"e_one": lint.Error,
+		"e_two": lint.Pass,
+		"w_one": lint.Warn,
+	}))
+	report.Add("bb", "CN=Issuer A", "01", resultSet(map[string]lint.LintStatus{
+		"e_one": lint.Error,
+		"e_two": lint.Error,
+		"w_one": lint.NA,
+	}))
+	report.Add("cc", "CN=Issuer B", "02", resultSet(map[string]lint.LintStatus{
+		"e_one": lint.Error,
+		"e_two": lint.NE,
+		"w_one": lint.Pass,
+	}))
+
+	if report.Inputs() != 3 {
+		t.Errorf("expected 3 inputs, got %d", report.Inputs())
+	}
+
+	top := report.TopFailing(0)
+	var names []string
+	for _, l := range top {
+		names = append(names, l.Name)
+	}
+	if expected := []string{"e_one", "e_two", "w_one"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected top failing lints %v, got %v", expected, names)
+	}
+	if expected := []string{"aa", "bb"}; !reflect.DeepEqual(top[0].Examples, expected) {
+		t.Errorf("expected examples %v, got %v", expected, top[0].Examples)
+	}
+	if len(report.TopFailing(1)) != 1 {
+		t.Errorf("expected TopFailing(1) to return a single lint")
+	}
+
+	issuers := report.Issuers()
+	if len(issuers) != 2 {
+		t.Fatalf("expected 2 issuers, got %d", len(issuers))
+	}
+	expectedA := StatusCount{NA: 1, Pass: 1, Warn: 1, Error: 3}
+	if issuers[0].DN != "CN=Issuer A" || issuers[0].Inputs != 2 || issuers[0].Counts != expectedA {
+		t.Errorf("unexpected aggregate for issuer A: %+v", issuers[0])
+	}
+
+	sources := report.Sources()
+	if len(sources) != 2 || sources[0].Source != lint.Community || sources[0].Counts != (StatusCount{NA: 1, Pass: 1, Warn: 1}) {
+		t.Errorf("unexpected source aggregates: %+v", sources)
+	}
+}
+
+func TestAggregateReportOutput(t *testing.T) {
+	report := NewAggregateReport(0)
+	report.Add("aa", "CN=Issuer A", "01", resultSet(map[string]lint.LintStatus{
+		"e_one": lint.Error,
+		"w_one": lint.Pass,
+	}))
+
+	var out bytes.Buffer
+	if err := report.WriteJSON(&out, 10); err != nil {
+		t.Fatal(err)
+	}
+	var decoded aggregateJSON
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Inputs != 1 || len(decoded.Lints) != 2 || !reflect.DeepEqual(decoded.TopFailing, []string{"e_one"}) {
+		t.Errorf("unexpected JSON report: %s", out.String())
+	}
+
+	out.Reset()
+	if err := report.WriteCSV(&out); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Header, two lints, one issuer and two sources.
+	if len(records) != 6 {
+		t.Fatalf("expected 6 CSV records, got %d", len(records))
+	}
+	if expected := []string{"lint", "e_one", "", "1", "0", "0", "0", "0", "0", "1", "0", "aa"}; !reflect.DeepEqual(records[1], expected) {
+		t.Errorf("expected CSV record %v, got %v", expected, records[1])
+	}
+
+	out.Reset()
+	report.WriteText(&out, 10)
+	if !bytes.Contains(out.Bytes(), []byte("e_one")) {
+		t.Errorf("expected text report to mention e_one, got %s", out.String())
+	}
+}
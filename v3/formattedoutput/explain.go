@@ -0,0 +1,401 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// attributeNames maps the OIDs of common distinguished name attributes to
+// their short names.
+var attributeNames = map[string]string{
+	util.CommonNameOID.String():             "CN",
+	util.SurnameOID.String():                "SN",
+	util.SerialOID.String():                 "serialNumber",
+	util.CountryNameOID.String():            "C",
+	util.LocalityNameOID.String():           "L",
+	util.StateOrProvinceNameOID.String():    "ST",
+	util.StreetAddressOID.String():          "street",
+	util.OrganizationNameOID.String():       "O",
+	util.OrganizationalUnitNameOID.String(): "OU",
+	util.BusinessOID.String():               "businessCategory",
+	util.PostalCodeOID.String():             "postalCode",
+	util.GivenNameOID.String():              "givenName",
+	"2.5.4.12":                              "title",
+	"2.5.4.97":                              "organizationIdentifier",
+	"0.9.2342.19200300.100.1.25":            "DC",
+	"1.2.840.113549.1.9.1":                  "emailAddress",
+	"1.3.6.1.4.1.311.60.2.1.1":              "jurisdictionL",
+	"1.3.6.1.4.1.311.60.2.1.2":              "jurisdictionST",
+	"1.3.6.1.4.1.311.60.2.1.3":              "jurisdictionC",
+}
+
+// extensionNames maps the OIDs of common extensions to their names.
+var extensionNames = map[string]string{
+	util.AiaOID.String():                  "authorityInfoAccess",
+	util.AuthkeyOID.String():              "authorityKeyIdentifier",
+	util.BasicConstOID.String():           "basicConstraints",
+	util.CertPolicyOID.String():           "certificatePolicies",
+	util.CrlDistOID.String():              "cRLDistributionPoints",
+	util.CtPoisonOID.String():             "ctPoison",
+	util.EkuSynOid.String():               "extKeyUsage",
+	util.FreshCRLOID.String():             "freshestCRL",
+	util.InhibitAnyPolicyOID.String():     "inhibitAnyPolicy",
+	util.IssuerAlternateNameOID.String():  "issuerAltName",
+	util.KeyUsageOID.String():             "keyUsage",
+	util.LogoTypeOID.String():             "logotype",
+	util.NameConstOID.String():            "nameConstraints",
+	util.OscpNoCheckOID.String():          "ocspNoCheck",
+	util.PolicyConstOID.String():          "policyConstraints",
+	util.PolicyMapOID.String():            "policyMappings",
+	util.PrivKeyUsageOID.String():         "privateKeyUsagePeriod",
+	util.QcStateOid.String():              "qcStatements",
+	util.TimestampOID.String():            "signedCertificateTimestampList",
+	util.SmimeOID.String():                "smimeCapabilities",
+	util.SubjectAlternateNameOID.String(): "subjectAltName",
+	util.SubjectDirAttrOID.String():       "subjectDirectoryAttributes",
+	util.SubjectInfoAccessOID.String():    "subjectInfoAccess",
+	util.SubjectKeyIdentityOID.String():   "subjectKeyIdentifier",
+}
+
+// stringTypeNames maps the universal tags of the ASN.1 string types used in
+// distinguished names to their names.
+var stringTypeNames = map[int]string{
+	asn1.TagUTF8String:      "UTF8String",
+	asn1.TagPrintableString: "PrintableString",
+	asn1.TagT61String:       "TeletexString",
+	asn1.TagIA5String:       "IA5String",
+	26:                      "VisibleString",
+	28:                      "UniversalString",
+	asn1.TagBMPString:       "BMPString",
+}
+
+// explainer renders a certificate, interleaving the findings of a ResultSet.
+type explainer struct {
+	w io.Writer
+	// findings holds the findings that have not yet been rendered, keyed by
+	// locationKey.
+	findings map[string][]namedFinding
+}
+
+// namedFinding is a lint.Finding together with the name of the lint that
+// produced it.
+type namedFinding struct {
+	name    string
+	finding lint.Finding
+}
+
+// locationKey returns the key under which findings at loc are grouped. Nil
+// locations share the empty key.
+func locationKey(loc *lint.Location) string {
+	return loc.String()
+}
+
+// ExplainCertificate writes an annotated, human-readable rendering of c to w
+// that shows every extension with its OID, criticality and decoded value, every
+// distinguished name attribute with its ASN.1 string type and every subject
+// alternative name.
+//
+// Each result in results that is above lint.Pass is printed directly beneath
+// the field named by its Location, or, for results listing several Findings,
+// each finding above lint.Pass beneath the field named by its own Location.
+// Findings without a Location, or whose Location names a field that is not
+// present in c, are printed at the end.
+func ExplainCertificate(w io.Writer, c *x509.Certificate, results *zlint.ResultSet) {
+	e := &explainer{w: w, findings: map[string][]namedFinding{}}
+	if results != nil {
+		for name, result := range results.Results {
+			findings := result.Findings
+			if len(findings) == 0 {
+				findings = []lint.Finding{{Status: result.Status, Details: result.Details, Location: result.Location}}
+			}
+			for _, finding := range findings {
+				if finding.Status <= lint.Pass {
+					continue
+				}
+				key := locationKey(finding.Location)
+				e.findings[key] = append(e.findings[key], namedFinding{name: name, finding: finding})
+			}
+		}
+	}
+
+	e.line(0, "Certificate:")
+	e.line(1, "Version: %d", c.Version)
+	e.flush(2, lint.AtField(lint.FieldVersion))
+	e.line(1, "Serial Number: %s", hexWithColons(c.SerialNumber.Bytes()))
+	e.flush(2, lint.AtField(lint.FieldSerialNumber))
+	e.line(1, "Signature: %s (%s)", c.SignatureAlgorithm, c.SignatureAlgorithmOID)
+	e.flush(2, lint.AtField(lint.FieldSignature))
+	e.name(1, "Issuer", lint.FieldIssuer, c.RawIssuer)
+	e.line(1, "Validity:")
+	e.line(2, "Not Before: %s", c.NotBefore.UTC().Format(time.RFC3339))
+	e.line(2, "Not After:  %s", c.NotAfter.UTC().Format(time.RFC3339))
+	e.flush(2, lint.AtField(lint.FieldValidity))
+	e.name(1, "Subject", lint.FieldSubject, c.RawSubject)
+	e.line(1, "Subject Public Key Info:")
+	e.line(2, "Algorithm: %s (%s)", c.PublicKeyAlgorithm, c.PublicKeyAlgorithmOID)
+	e.line(2, "SPKI SHA-256: %s", c.SPKIFingerprint.Hex())
+	e.flush(2, lint.AtField(lint.FieldSubjectPublicKeyInfo))
+	e.line(1, "Extensions:")
+	e.flush(2, lint.AtField(lint.FieldExtensions))
+	for _, ext := range c.Extensions {
+		e.extension(2, c, ext)
+	}
+	e.line(1, "Signature Algorithm: %s (%s)", c.SignatureAlgorithm, c.SignatureAlgorithmOID)
+	e.flush(2, lint.AtField(lint.FieldSignatureAlgorithm))
+	e.flush(2, lint.AtField(lint.FieldSignatureValue))
+
+	var remaining []namedFinding
+	for _, findings := range e.findings {
+		remaining = append(remaining, findings...)
+	}
+	if len(remaining) > 0 {
+		e.line(0, "Other findings:")
+		e.print(1, remaining)
+	}
+}
+
+// line writes a single indented line.
+func (e *explainer) line(depth int, format string, args ...interface{}) {
+	fmt.Fprintf(e.w, "%s%s\n", strings.Repeat("    ", depth), fmt.Sprintf(format, args...))
+}
+
+// flush writes, and forgets, the findings recorded at loc.
+func (e *explainer) flush(depth int, loc *lint.Location) {
+	key := locationKey(loc)
+	e.print(depth, e.findings[key])
+	delete(e.findings, key)
+}
+
+// print writes the given findings in lint name order.
+func (e *explainer) print(depth int, findings []namedFinding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].name < findings[j].name
+	})
+	for _, f := range findings {
+		if f.finding.Details != "" {
+			e.line(depth, ">> %s: %s: %s", f.finding.Status, f.name, f.finding.Details)
+		} else {
+			e.line(depth, ">> %s: %s", f.finding.Status, f.name)
+		}
+	}
+}
+
+// name renders the distinguished name encoded in raw, one attribute per line.
+func (e *explainer) name(depth int, label string, field lint.Field, raw []byte) {
+	e.line(depth, "%s:", label)
+	e.flush(depth+1, lint.AtField(field))
+	var rdns util.RawRDNSequence
+	if _, err := asn1.Unmarshal(raw, &rdns); err != nil {
+		e.line(depth+1, "<unable to parse %s: %s>", field, err)
+		return
+	}
+	flushed := map[string]bool{}
+	for i, rdn := range rdns {
+		for _, atv := range rdn {
+			oid := atv.Type.String()
+			e.line(depth+1, "%s (%s) %s: %s", attributeName(oid), oid, stringTypeName(atv.Value), decodeString(atv.Value))
+			index := i
+			e.flush(depth+2, &lint.Location{Field: field, Attribute: oid, RDNIndex: &index})
+			if !flushed[oid] {
+				e.flush(depth+2, &lint.Location{Field: field, Attribute: oid})
+				flushed[oid] = true
+			}
+		}
+	}
+}
+
+// extension renders a single extension and its decoded value.
+func (e *explainer) extension(depth int, c *x509.Certificate, ext pkix.Extension) {
+	oid := ext.Id.String()
+	name, ok := extensionNames[oid]
+	if !ok {
+		name = "unknown"
+	}
+	critical := ""
+	if ext.Critical {
+		critical = " critical"
+	}
+	e.line(depth, "%s (%s)%s:", name, oid, critical)
+	e.flush(depth+1, lint.AtExtension(ext.Id))
+	if ext.Id.Equal(util.SubjectAlternateNameOID) || ext.Id.Equal(util.IssuerAlternateNameOID) {
+		e.generalNames(depth+1, ext)
+		return
+	}
+	for _, value := range decodeExtension(c, ext) {
+		e.line(depth+1, "%s", value)
+	}
+}
+
+// generalNames renders each entry of a subjectAltName or issuerAltName
+// extension, followed by the findings that point at that entry.
+func (e *explainer) generalNames(depth int, ext pkix.Extension) {
+	names, err := util.ParseGeneralNames(ext.Value)
+	if err != nil {
+		e.line(depth, "<unable to parse GeneralNames: %s>", err)
+		return
+	}
+	for i, name := range names {
+		e.line(depth, "[%d] %s: %s", i, util.GeneralNameType(name.Tag), decodeGeneralName(name))
+		e.flush(depth+1, lint.AtGeneralName(ext.Id, i, name.Tag))
+	}
+}
+
+// decodeExtension returns a human-readable rendering of the value of ext, one
+// element per line. Extensions without a specific rendering are shown in hex.
+func decodeExtension(c *x509.Certificate, ext pkix.Extension) []string {
+	switch {
+	case ext.Id.Equal(util.KeyUsageOID):
+		usages := util.GetKeyUsageStrings(c.KeyUsage)
+		sort.Strings(usages)
+		return []string{strings.Join(usages, ", ")}
+	case ext.Id.Equal(util.EkuSynOid):
+		var usages []string
+		for _, eku := range c.ExtKeyUsage {
+			usages = append(usages, util.GetEKUString(eku))
+		}
+		for _, oid := range c.UnknownExtKeyUsage {
+			usages = append(usages, oid.String())
+		}
+		return []string{strings.Join(usages, ", ")}
+	case ext.Id.Equal(util.BasicConstOID):
+		if c.MaxPathLen > 0 || c.MaxPathLenZero {
+			return []string{fmt.Sprintf("CA: %t, pathLenConstraint: %d", c.IsCA, c.MaxPathLen)}
+		}
+		return []string{fmt.Sprintf("CA: %t", c.IsCA)}
+	case ext.Id.Equal(util.SubjectKeyIdentityOID):
+		return []string{hexWithColons(c.SubjectKeyId)}
+	case ext.Id.Equal(util.AuthkeyOID):
+		return []string{"keyIdentifier: " + hexWithColons(c.AuthorityKeyId)}
+	case ext.Id.Equal(util.CertPolicyOID):
+		var lines []string
+		for _, oid := range c.PolicyIdentifiers {
+			lines = append(lines, oid.String())
+		}
+		return lines
+	case ext.Id.Equal(util.AiaOID):
+		var lines []string
+		for _, url := range c.OCSPServer {
+			lines = append(lines, "OCSP: "+url)
+		}
+		for _, url := range c.IssuingCertificateURL {
+			lines = append(lines, "CA Issuers: "+url)
+		}
+		return lines
+	case ext.Id.Equal(util.CrlDistOID):
+		return c.CRLDistributionPoints
+	}
+	return []string{hexWithColons(ext.Value)}
+}
+
+// decodeGeneralName returns a human-readable rendering of a single GeneralName
+// as returned by util.ParseGeneralNames.
+func decodeGeneralName(name asn1.RawValue) string {
+	switch name.Tag {
+	case util.RFC822NameTag, util.DNSNameTag, util.UniformResourceIdentifierTag:
+		return fmt.Sprintf("%q", name.Bytes)
+	case util.IPAddressTag:
+		if len(name.Bytes) == net.IPv4len || len(name.Bytes) == net.IPv6len {
+			return net.IP(name.Bytes).String()
+		}
+	case util.RegisteredIDTag:
+		// registeredID is IMPLICIT so re-tag it as an OBJECT IDENTIFIER to decode.
+		der, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagOID, Bytes: name.Bytes})
+		var oid asn1.ObjectIdentifier
+		if err == nil {
+			if _, err = asn1.Unmarshal(der, &oid); err == nil {
+				return oid.String()
+			}
+		}
+	case util.DirectoryNameTag:
+		var rdns pkix.RDNSequence
+		if _, err := asn1.Unmarshal(name.Bytes, &rdns); err == nil {
+			var dn pkix.Name
+			dn.FillFromRDNSequence(&rdns)
+			return dn.String()
+		}
+	}
+	return hexWithColons(name.Bytes)
+}
+
+// attributeName returns the short name of the attribute with the given OID,
+// or "unknown".
+func attributeName(oid string) string {
+	if name, ok := attributeNames[oid]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// stringTypeName returns the name of the ASN.1 type of value.
+func stringTypeName(value asn1.RawValue) string {
+	if name, ok := stringTypeNames[value.Tag]; ok && value.Class == asn1.ClassUniversal {
+		return name
+	}
+	return fmt.Sprintf("[class %d, tag %d]", value.Class, value.Tag)
+}
+
+// decodeString returns the quoted contents of a distinguished name attribute
+// value, decoding the multi-byte BMPString and UniversalString types.
+func decodeString(value asn1.RawValue) string {
+	if value.Class != asn1.ClassUniversal {
+		return hexWithColons(value.Bytes)
+	}
+	switch value.Tag {
+	case asn1.TagBMPString:
+		if len(value.Bytes)%2 != 0 {
+			break
+		}
+		units := make([]uint16, 0, len(value.Bytes)/2)
+		for i := 0; i < len(value.Bytes); i += 2 {
+			units = append(units, uint16(value.Bytes[i])<<8|uint16(value.Bytes[i+1]))
+		}
+		return fmt.Sprintf("%q", string(utf16.Decode(units)))
+	case 28:
+		if len(value.Bytes)%4 != 0 {
+			break
+		}
+		runes := make([]rune, 0, len(value.Bytes)/4)
+		for i := 0; i < len(value.Bytes); i += 4 {
+			runes = append(runes, rune(value.Bytes[i])<<24|rune(value.Bytes[i+1])<<16|rune(value.Bytes[i+2])<<8|rune(value.Bytes[i+3]))
+		}
+		return fmt.Sprintf("%q", string(runes))
+	}
+	return fmt.Sprintf("%q", value.Bytes)
+}
+
+// hexWithColons renders b as colon separated hex octets, as done by OpenSSL.
+func hexWithColons(b []byte) string {
+	encoded := hex.EncodeToString(b)
+	var octets []string
+	for i := 0; i < len(encoded); i += 2 {
+		octets = append(octets, encoded[i:i+2])
+	}
+	return strings.Join(octets, ":")
+}
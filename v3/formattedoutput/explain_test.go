@@ -0,0 +1,91 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package formattedoutput
+
+import (
+	"bytes"
+	"encoding/pem"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func readCert(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+	data, err := os.ReadFile("../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("unable to PEM decode %s", name)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestExplainCertificate(t *testing.T) {
+	c := readCert(t, "keyUsageNotCriticalSubCert.pem")
+	results := &zlint.ResultSet{Results: map[string]*lint.LintResult{
+		"w_ku": {Status: lint.Warn, Details: "ku", Location: lint.AtExtension(util.KeyUsageOID)},
+		"e_cn": {Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)},
+		"e_no": {Status: lint.Error, Details: "nowhere"},
+		"e_ok": {Status: lint.Pass, Location: lint.AtField(lint.FieldValidity)},
+	}}
+
+	var out bytes.Buffer
+	ExplainCertificate(&out, c, results)
+	lines := strings.Split(out.String(), "\n")
+
+	// findingAfter returns true if the finding is printed on the line directly
+	// following a line that contains prefix, or following that line's value.
+	findingAfter := func(prefix, finding string) bool {
+		for i, line := range lines {
+			if !strings.Contains(line, prefix) {
+				continue
+			}
+			for _, next := range lines[i+1 : min(i+3, len(lines))] {
+				if strings.Contains(next, finding) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if !findingAfter("keyUsage (2.5.29.15)", ">> warn: w_ku: ku") {
+		t.Errorf("expected w_ku beneath the keyUsage extension, got:\n%s", out.String())
+	}
+	if !findingAfter("CN (2.5.4.3) PrintableString", ">> error: e_cn") {
+		t.Errorf("expected e_cn beneath the subject CN, got:\n%s", out.String())
+	}
+	if !findingAfter("Other findings:", ">> error: e_no: nowhere") {
+		t.Errorf("expected e_no amongst the other findings, got:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "e_ok") {
+		t.Errorf("expected passing results to be omitted, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), `[1] dNSName: "theca.net"`) {
+		t.Errorf("expected the issuerAltName entries to be rendered, got:\n%s", out.String())
+	}
+}
@@ -2,6 +2,8 @@ package formattedoutput
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -78,7 +80,7 @@ func OutputSummary(zlintResult *zlint.ResultSet, longSummary bool) {
 		var lsl string
 		var rescount string
 
-		hlengths := printTableHeadings(headings)
+		hlengths := printTableHeadings(os.Stdout, headings)
 		// Construct the table lines, but don't repeat
 		// LintStatus(level) or the results count.  Also, just
 		// because a level wasn't seen doesn't mean it isn't
@@ -105,34 +107,34 @@ func OutputSummary(zlintResult *zlint.ResultSet, longSummary bool) {
 				})
 			}
 		}
-		printTableBody(hlengths, lines)
+		printTableBody(os.Stdout, hlengths, lines)
 	} else {
 		headings := []string{"Level", "# occurrences"}
-		hlengths := printTableHeadings(headings)
+		hlengths := printTableHeadings(os.Stdout, headings)
 		lines := [][]string{}
 		for _, level := range rt.sortedLevels {
 			lines = append(lines, []string{
 				lint.LintStatus(level).String(),
 				strconv.Itoa(rt.resultCount[lint.LintStatus(level)])})
 		}
-		printTableBody(hlengths, lines)
+		printTableBody(os.Stdout, hlengths, lines)
 		fmt.Printf("\n")
 	}
 }
 
-func printTableHeadings(headings []string) []int {
+func printTableHeadings(w io.Writer, headings []string) []int {
 	hlengths := []int{}
 	for i, h := range headings {
 		hlengths = append(
 			hlengths,
 			utf8.RuneCountInString(h)+1)
-		fmt.Printf("| %s ", strings.ToUpper(h))
+		fmt.Fprintf(w, "| %s ", strings.ToUpper(h))
 		if i == len(headings)-1 {
-			fmt.Printf("|\n")
+			fmt.Fprintf(w, "|\n")
 			for ii, j := range hlengths {
-				fmt.Printf("+%s", strings.Repeat("-", j+1))
+				fmt.Fprintf(w, "+%s", strings.Repeat("-", j+1))
 				if ii == len(headings)-1 {
-					fmt.Printf("+\n")
+					fmt.Fprintf(w, "+\n")
 				}
 			}
 		}
@@ -140,17 +142,34 @@ func printTableHeadings(headings []string) []int {
 	return hlengths
 }
 
-func printTableBody(hlengths []int, lines [][]string) {
+// padTableHeadings returns headings with each one padded with spaces so that
+// its column is wide enough to hold the longest value in that column, since
+// printTableBody truncates values to the width of their heading.
+func padTableHeadings(headings []string, lines [][]string) []string {
+	padded := make([]string, len(headings))
+	for column, heading := range headings {
+		width := utf8.RuneCountInString(heading)
+		for _, line := range lines {
+			if n := utf8.RuneCountInString(line[column]); n > width {
+				width = n
+			}
+		}
+		padded[column] = heading + strings.Repeat(" ", width-utf8.RuneCountInString(heading))
+	}
+	return padded
+}
+
+func printTableBody(w io.Writer, hlengths []int, lines [][]string) {
 	for _, line := range lines {
 		for i, hlen := range hlengths {
 			// This makes a format string with the
 			// right widths, e.g. "%7.7s"
 			fmtstring := fmt.Sprintf("|%%%[1]d.%[1]ds", hlen)
-			fmt.Printf(fmtstring, line[i])
+			fmt.Fprintf(w, fmtstring, line[i])
 			if i == len(hlengths)-1 {
-				fmt.Printf(" |\n")
+				fmt.Fprintf(w, " |\n")
 			} else {
-				fmt.Printf(" ")
+				fmt.Fprintf(w, " ")
 			}
 		}
 	}
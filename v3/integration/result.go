@@ -5,6 +5,7 @@ package integration
 import (
 	"fmt"
 
+	"github.com/zmap/zlint/v3/formattedoutput"
 	"github.com/zmap/zlint/v3/lint"
 )
 
@@ -26,18 +27,16 @@ func (r resultCount) String() string {
 		r.FatalCount, r.ErrCount, r.WarnCount, r.NoticeCount)
 }
 
-// Inc increases the resultCount count for the given lint status level.
+// Inc increases the resultCount count for the given lint status level. The
+// status is counted by formattedoutput.StatusCount so that the integration
+// tests and aggregate reports agree on how results are counted.
 func (r *resultCount) Inc(status lint.LintStatus) {
-	switch status {
-	case lint.Notice:
-		r.NoticeCount++
-	case lint.Warn:
-		r.WarnCount++
-	case lint.Error:
-		r.ErrCount++
-	case lint.Fatal:
-		r.FatalCount++
-	}
+	var c formattedoutput.StatusCount
+	c.Inc(status)
+	r.FatalCount += uint32(c.Fatal)
+	r.ErrCount += uint32(c.Error)
+	r.WarnCount += uint32(c.Warn)
+	r.NoticeCount += uint32(c.Notice)
 }
 
 // certResult combines a Result (overall count of lint results by type) with
@@ -38,6 +38,10 @@ type RevocationListLintInterface interface {
 
 	// Execute is the body of the lint. It is called for every revocation list
 	// for which CheckApplies returns true.
+	//
+	// The same configured instance is used for every revocation list linted
+	// with a given Configuration, possibly from several goroutines at once, so
+	// neither CheckApplies nor Execute may modify the lint.
 	Execute(r *x509.RevocationList) *LintResult
 }
 
@@ -51,9 +55,26 @@ type CertificateLintInterface interface {
 
 	// Execute is the body of the lint. It is called for every certificate for
 	// which CheckApplies returns true.
+	//
+	// The same configured instance is used for every certificate linted with
+	// a given Configuration, possibly from several goroutines at once, so
+	// neither CheckApplies nor Execute may modify the lint.
 	Execute(c *x509.Certificate) *LintResult
 }
 
+// CertificateContextLintInterface may be implemented by certificate linters,
+// in addition to CertificateLintInterface, in order to share the views of a
+// certificate held by a CertificateContext with other lints. When it is
+// implemented, CheckAppliesWithContext and ExecuteWithContext are called in
+// place of CheckApplies and Execute.
+type CertificateContextLintInterface interface {
+	// CheckAppliesWithContext has the same meaning as CheckApplies.
+	CheckAppliesWithContext(cc *CertificateContext) bool
+
+	// ExecuteWithContext has the same meaning as Execute.
+	ExecuteWithContext(cc *CertificateContext) *LintResult
+}
+
 // Configurable lints return a pointer into a struct that they wish to receive their configuration into.
 type Configurable interface {
 	Configure() interface{}
@@ -91,8 +112,25 @@ type LintMetadata struct {
 	// true but with NotBefore >= IneffectiveDate. This check is bypassed if
 	// IneffectiveDate is zero. Please see CheckEffective for more information.
 	IneffectiveDate time.Time `json:"-"`
+
+	// A hint of how expensive the lint is to execute relative to other lints.
+	// Callers that want a quick answer may choose to run cheaper lints first.
+	Cost LintCost `json:"-"`
 }
 
+// LintCost is a hint of how expensive a lint is to execute.
+type LintCost int
+
+// Known LintCost values, from cheapest to most expensive.
+const (
+	// DefaultCost is the cost of the vast majority of lints, which inspect
+	// the parsed fields of their input.
+	DefaultCost LintCost = 0
+	// ExpensiveCost is the cost of lints that perform significant
+	// computation, such as attempting to factor a public key.
+	ExpensiveCost LintCost = 1
+)
+
 // A Lint struct represents a single lint, e.g.
 // "e_basic_constraints_not_critical". It contains an implementation of LintInterface.
 //
@@ -219,7 +257,14 @@ func (l *CertificateLint) CheckEffective(c *x509.Certificate) bool {
 // CheckApplies()
 // CheckEffective()
 // Execute()
-func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration) (result *LintResult) {
+func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration) *LintResult {
+	return l.ExecuteWithContext(NewCertificateContext(cert), config)
+}
+
+// ExecuteWithContext is the same as Execute, save that the certificate is
+// taken from the provided CertificateContext so that it may be shared by
+// every lint that runs against that certificate.
+func (l *CertificateLint) ExecuteWithContext(cc *CertificateContext, config Configuration) (result *LintResult) {
 	defer func() {
 		if err := recover(); err != nil {
 			details := fmt.Sprintf("'%s' panicked. Error: %v", l.Name, err)
@@ -229,7 +274,7 @@ func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration)
 			}
 		}
 	}()
-	result = l.execute(cert, config)
+	result = l.execute(cc, config)
 	return
 }
 
@@ -243,7 +288,8 @@ func (l *CertificateLint) Execute(cert *x509.Certificate, config Configuration)
 // CheckApplies()
 // CheckEffective()
 // Execute()
-func (l *CertificateLint) execute(cert *x509.Certificate, config Configuration) *LintResult {
+func (l *CertificateLint) execute(cc *CertificateContext, config Configuration) *LintResult {
+	cert := cc.Certificate
 	if l.Source == CABFBaselineRequirements && !util.IsServerAuthCert(cert) {
 		return &LintResult{Status: NA}
 	}
@@ -253,18 +299,27 @@ func (l *CertificateLint) execute(cert *x509.Certificate, config Configuration)
 	if l.Source == CABFCSBaselineRequirements && !util.IsCodeSigning(cert.PolicyIdentifiers) {
 		return &LintResult{Status: NA}
 	}
-	lint := l.Lint()
-	err := config.MaybeConfigure(lint, l.Name)
+	lint, err := config.configuredCertificateLint(l)
 	if err != nil {
 		return &LintResult{
 			Status:  Fatal,
 			Details: err.Error()}
 	}
-	if !lint.CheckApplies(cert) {
+	contextLint, hasContext := lint.(CertificateContextLintInterface)
+	var applies bool
+	if hasContext {
+		applies = contextLint.CheckAppliesWithContext(cc)
+	} else {
+		applies = lint.CheckApplies(cert)
+	}
+	if !applies {
 		return &LintResult{Status: NA}
 	} else if !l.CheckEffective(cert) {
 		return &LintResult{Status: NE}
 	}
+	if hasContext {
+		return contextLint.ExecuteWithContext(cc)
+	}
 	return lint.Execute(cert)
 }
 
@@ -297,8 +352,7 @@ func (l *RevocationListLint) CheckEffective(r *x509.RevocationList) bool {
 // CheckEffective()
 // Execute()
 func (l *RevocationListLint) Execute(r *x509.RevocationList, config Configuration) *LintResult {
-	lint := l.Lint()
-	err := config.MaybeConfigure(lint, l.Name)
+	lint, err := config.configuredRevocationListLint(l)
 	if err != nil {
 		return &LintResult{
 			Status:  Fatal,
@@ -337,6 +391,10 @@ type OcspResponseLintInterface interface {
 
 	// Execute is the body of the lint. It is called for every OCSP response for
 	// which CheckApplies returns true.
+	//
+	// The same configured instance is used for every OCSP response linted with
+	// a given Configuration, possibly from several goroutines at once, so
+	// neither CheckApplies nor Execute may modify the lint.
 	Execute(o *ocsp.Response) *LintResult
 }
 
@@ -369,8 +427,7 @@ func (l *OcspResponseLint) CheckEffective(o *ocsp.Response) bool {
 // CheckEffective()
 // Execute()
 func (l *OcspResponseLint) Execute(o *ocsp.Response, config Configuration) *LintResult {
-	lint := l.Lint()
-	err := config.MaybeConfigure(lint, l.Name)
+	lint, err := config.configuredOcspResponseLint(l)
 	if err != nil {
 		return &LintResult{
 			Status:  Fatal,
@@ -383,3 +440,157 @@ func (l *OcspResponseLint) Execute(o *ocsp.Response, config Configuration) *Lint
 	}
 	return lint.Execute(o)
 }
+
+// RawCertificateLintInterface is implemented by each linter of raw, DER
+// encoded, certificates. Raw certificate lints are executed on certificates that
+// zcrypto is unable to parse, in place of the certificate lints, so that
+// malformed certificates still produce findings. The certificate is split into
+// its elements by util.ParseRawCertificate once, and shared by every lint.
+type RawCertificateLintInterface interface {
+	// CheckApplies runs once per certificate. It returns true if the Lint
+	// should run on the given raw certificate. If CheckApplies returns false,
+	// the Lint result is automatically set to NA without calling
+	// CheckEffective() or Run().
+	CheckApplies(raw *util.RawCertificate) bool
+
+	// Execute is the body of the lint. It is called for every certificate for
+	// which CheckApplies returns true.
+	//
+	// The same configured instance is used for every certificate linted with
+	// a given Configuration, possibly from several goroutines at once, so
+	// neither CheckApplies nor Execute may modify the lint.
+	Execute(raw *util.RawCertificate) *LintResult
+}
+
+// RawCertificateLint represents a single raw certificate linter.
+type RawCertificateLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() RawCertificateLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the notBefore of the raw certificate is on or
+// after the EffectiveDate AND before (but not on) the Ineffective date. That
+// is, CheckEffective returns true if...
+//
+//	notBefore in [EffectiveDate, IneffectiveDate)
+//
+// If the notBefore of raw can not be decoded then CheckEffective always returns
+// true, as a certificate that can not be placed in time is in scope of every
+// lint.
+func (l *RawCertificateLint) CheckEffective(raw *util.RawCertificate) bool {
+	notBefore, err := util.ParseRawTime(raw.NotBefore)
+	if err != nil {
+		return true
+	}
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, notBefore)
+}
+
+// Execute runs the lint against a raw certificate, as split into its elements
+// by util.ParseRawCertificate.
+// The ordering is as follows:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+func (l *RawCertificateLint) Execute(raw *util.RawCertificate, config Configuration) (result *LintResult) {
+	defer func() {
+		if err := recover(); err != nil {
+			details := fmt.Sprintf("'%s' panicked. Error: %v", l.Name, err)
+			result = &LintResult{
+				Status:  Fatal,
+				Details: details,
+			}
+		}
+	}()
+	result = l.execute(raw, config)
+	return
+}
+
+func (l *RawCertificateLint) execute(raw *util.RawCertificate, config Configuration) *LintResult {
+	lint, err := config.configuredRawCertificateLint(l)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(raw) {
+		return &LintResult{Status: NA}
+	} else if !l.CheckEffective(raw) {
+		return &LintResult{Status: NE}
+	}
+	return lint.Execute(raw)
+}
+
+// CertificateRequestLintInterface is implemented by each linter of PKCS#10
+// certificate signing requests.
+type CertificateRequestLintInterface interface {
+	// CheckApplies runs once per certificate signing request. It returns true
+	// if the Lint should run on the given request. If CheckApplies returns
+	// false, the Lint result is automatically set to NA without calling
+	// CheckEffective() or Run().
+	CheckApplies(r *x509.CertificateRequest) bool
+
+	// Execute is the body of the lint. It is called for every certificate
+	// signing request for which CheckApplies returns true.
+	//
+	// The same configured instance is used for every request linted with
+	// a given Configuration, possibly from several goroutines at once, so
+	// neither CheckApplies nor Execute may modify the lint.
+	Execute(r *x509.CertificateRequest) *LintResult
+}
+
+// CertificateRequestLint represents a single certificate signing request
+// linter.
+type CertificateRequestLint struct {
+	// Metadata associated with the linter.
+	LintMetadata
+	// A constructor which returns the implementation of the linter.
+	Lint func() CertificateRequestLintInterface `json:"-"`
+}
+
+// CheckEffective returns true if the current time is on or after the
+// EffectiveDate AND before (but not on) the Ineffective date. A certificate
+// signing request carries no date of its own, and is linted when a certificate
+// is about to be issued for it, so it is the current time that is checked.
+func (l *CertificateRequestLint) CheckEffective(_ *x509.CertificateRequest) bool {
+	return checkEffective(l.EffectiveDate, l.IneffectiveDate, time.Now())
+}
+
+// Execute runs the lint against a certificate signing request.
+// The ordering is as follows:
+//
+// Configure() ----> only if the lint implements Configurable
+// CheckApplies()
+// CheckEffective()
+// Execute()
+func (l *CertificateRequestLint) Execute(r *x509.CertificateRequest, config Configuration) (result *LintResult) {
+	defer func() {
+		if err := recover(); err != nil {
+			details := fmt.Sprintf("'%s' panicked. Error: %v", l.Name, err)
+			result = &LintResult{
+				Status:  Fatal,
+				Details: details,
+			}
+		}
+	}()
+	result = l.execute(r, config)
+	return
+}
+
+func (l *CertificateRequestLint) execute(r *x509.CertificateRequest, config Configuration) *LintResult {
+	lint, err := config.configuredCertificateRequestLint(l)
+	if err != nil {
+		return &LintResult{
+			Status:  Fatal,
+			Details: err.Error()}
+	}
+	if !lint.CheckApplies(r) {
+		return &LintResult{Status: NA}
+	} else if !l.CheckEffective(r) {
+		return &LintResult{Status: NE}
+	}
+	return lint.Execute(r)
+}
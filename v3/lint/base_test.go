@@ -345,3 +345,36 @@ func (l *PanicLint) CheckApplies(_ *x509.Certificate) bool {
 func (l *PanicLint) Execute(_ *x509.Certificate) *LintResult {
 	panic("Earth shattering kaboom")
 }
+
+func TestPanicRawCertificateLint(t *testing.T) {
+	lint := &RawCertificateLint{
+		LintMetadata: LintMetadata{
+			Name:          "lgtm_raw",
+			Description:   "bad code go boom",
+			Citation:      "not a chance",
+			Source:        RFC5280,
+			EffectiveDate: util.RFC5280Date,
+		},
+		Lint: NewPanicRawCertificateLint,
+	}
+	raw, _ := util.ParseRawCertificate([]byte{0x30, 0x00})
+	result := lint.Execute(raw, Configuration{})
+	if result.Status != Fatal {
+		t.Errorf("Lint failed, expected Fatal, got %v", result.Status)
+	}
+}
+
+type PanicRawCertificateLint struct {
+}
+
+func NewPanicRawCertificateLint() RawCertificateLintInterface {
+	return &PanicRawCertificateLint{}
+}
+
+func (l *PanicRawCertificateLint) CheckApplies(_ *util.RawCertificate) bool {
+	return true
+}
+
+func (l *PanicRawCertificateLint) Execute(_ *util.RawCertificate) *LintResult {
+	panic("Earth shattering kaboom")
+}
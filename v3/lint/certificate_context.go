@@ -0,0 +1,173 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"sync"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// CertificateContext wraps a certificate together with views of it that many
+// lints derive for themselves, such as its raw distinguished names or its
+// parsed QC statements. Each view is computed the first time that it is asked
+// for and then shared by every lint that runs against the certificate.
+//
+// A CertificateContext is safe for concurrent use. Lints must not modify the
+// values that it returns.
+type CertificateContext struct {
+	// Certificate is the certificate being linted.
+	Certificate *x509.Certificate
+
+	subjectOnce sync.Once
+	subject     rawRDNSequence
+	issuerOnce  sync.Once
+	issuer      rawRDNSequence
+
+	timesOnce sync.Once
+	notBefore asn1.RawValue
+	notAfter  asn1.RawValue
+
+	sanOnce sync.Once
+	san     generalNames
+	ianOnce sync.Once
+	ian     generalNames
+
+	mu           sync.Mutex
+	qcStatements map[string]util.EtsiQcStmtIf
+	unicode      map[string]idnaResult
+}
+
+type rawRDNSequence struct {
+	rdns util.RawRDNSequence
+	rest []byte
+	err  error
+}
+
+type generalNames struct {
+	names []asn1.RawValue
+	err   error
+}
+
+type idnaResult struct {
+	unicode string
+	err     error
+}
+
+// NewCertificateContext returns an empty CertificateContext for c.
+func NewCertificateContext(c *x509.Certificate) *CertificateContext {
+	return &CertificateContext{Certificate: c}
+}
+
+// RawSubject returns the subject of the certificate as a util.RawRDNSequence,
+// along with any trailing data and error as returned by asn1.Unmarshal.
+func (cc *CertificateContext) RawSubject() (util.RawRDNSequence, []byte, error) {
+	cc.subjectOnce.Do(func() {
+		cc.subject = unmarshalRawRDNSequence(cc.Certificate.RawSubject)
+	})
+	return cc.subject.rdns, cc.subject.rest, cc.subject.err
+}
+
+// RawIssuer returns the issuer of the certificate as a util.RawRDNSequence,
+// along with any trailing data and error as returned by asn1.Unmarshal.
+func (cc *CertificateContext) RawIssuer() (util.RawRDNSequence, []byte, error) {
+	cc.issuerOnce.Do(func() {
+		cc.issuer = unmarshalRawRDNSequence(cc.Certificate.RawIssuer)
+	})
+	return cc.issuer.rdns, cc.issuer.rest, cc.issuer.err
+}
+
+func unmarshalRawRDNSequence(der []byte) rawRDNSequence {
+	var seq rawRDNSequence
+	seq.rest, seq.err = asn1.Unmarshal(der, &seq.rdns)
+	return seq
+}
+
+// Times returns the encoded notBefore and notAfter of the certificate, as
+// returned by util.GetTimes.
+func (cc *CertificateContext) Times() (asn1.RawValue, asn1.RawValue) {
+	cc.timesOnce.Do(func() {
+		cc.notBefore, cc.notAfter = util.GetTimes(cc.Certificate)
+	})
+	return cc.notBefore, cc.notAfter
+}
+
+// SubjectAltNames returns the GeneralNames of the subjectAltName extension in
+// encoded order, as returned by util.ParseGeneralNames. If the extension is
+// absent then both return values are nil.
+func (cc *CertificateContext) SubjectAltNames() ([]asn1.RawValue, error) {
+	cc.sanOnce.Do(func() {
+		cc.san = parseAltNames(cc.Certificate, util.SubjectAlternateNameOID)
+	})
+	return cc.san.names, cc.san.err
+}
+
+// IssuerAltNames returns the GeneralNames of the issuerAltName extension in
+// encoded order, as returned by util.ParseGeneralNames. If the extension is
+// absent then both return values are nil.
+func (cc *CertificateContext) IssuerAltNames() ([]asn1.RawValue, error) {
+	cc.ianOnce.Do(func() {
+		cc.ian = parseAltNames(cc.Certificate, util.IssuerAlternateNameOID)
+	})
+	return cc.ian.names, cc.ian.err
+}
+
+func parseAltNames(c *x509.Certificate, oid asn1.ObjectIdentifier) generalNames {
+	ext := util.GetExtFromCert(c, oid)
+	if ext == nil {
+		return generalNames{}
+	}
+	names, err := util.ParseGeneralNames(ext.Value)
+	return generalNames{names: names, err: err}
+}
+
+// QcStatement returns the QC statement identified by oid, as returned by
+// util.ParseQcStatem, from the QC statements extension of the certificate.
+// The extension must be present.
+func (cc *CertificateContext) QcStatement(oid asn1.ObjectIdentifier) util.EtsiQcStmtIf {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if statement, ok := cc.qcStatements[oid.String()]; ok {
+		return statement
+	}
+	var value []byte
+	if ext := util.GetExtFromCert(cc.Certificate, util.QcStateOid); ext != nil {
+		value = ext.Value
+	}
+	statement := util.ParseQcStatem(value, oid)
+	if cc.qcStatements == nil {
+		cc.qcStatements = map[string]util.EtsiQcStmtIf{}
+	}
+	cc.qcStatements[oid.String()] = statement
+	return statement
+}
+
+// IdnaToUnicode returns the result of util.IdnaToUnicode for label, which is
+// typically a single label of one of the certificate's DNS names.
+func (cc *CertificateContext) IdnaToUnicode(label string) (string, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if result, ok := cc.unicode[label]; ok {
+		return result.unicode, result.err
+	}
+	unicode, err := util.IdnaToUnicode(label)
+	if cc.unicode == nil {
+		cc.unicode = map[string]idnaResult{}
+	}
+	cc.unicode[label] = idnaResult{unicode: unicode, err: err}
+	return unicode, err
+}
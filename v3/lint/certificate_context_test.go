@@ -0,0 +1,107 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/pem"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func readCertificate(t *testing.T, name string) *x509.Certificate {
+	data, err := os.ReadFile("../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("failed to PEM decode %s", name)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCertificateContext(t *testing.T) {
+	c := readCertificate(t, "SANDNSDuplicate.pem")
+	cc := NewCertificateContext(c)
+
+	subject, rest, err := cc.RawSubject()
+	if err != nil || len(rest) != 0 || len(subject) == 0 {
+		t.Fatalf("unexpected raw subject %v, rest %x, error %v", subject, rest, err)
+	}
+	again, _, _ := cc.RawSubject()
+	if &again[0] != &subject[0] {
+		t.Errorf("expected the raw subject to be parsed once and reused")
+	}
+	if issuer, _, err := cc.RawIssuer(); err != nil || len(issuer) == 0 {
+		t.Errorf("unexpected raw issuer %v, error %v", issuer, err)
+	}
+
+	notBefore, notAfter := cc.Times()
+	expectedNotBefore, expectedNotAfter := util.GetTimes(c)
+	if !reflect.DeepEqual(notBefore, expectedNotBefore) || !reflect.DeepEqual(notAfter, expectedNotAfter) {
+		t.Errorf("expected times %v and %v, got %v and %v", expectedNotBefore, expectedNotAfter, notBefore, notAfter)
+	}
+
+	names, err := cc.SubjectAltNames()
+	if err != nil || len(names) != len(c.DNSNames) {
+		t.Errorf("expected %d subjectAltNames, got %d (error %v)", len(c.DNSNames), len(names), err)
+	}
+	if names, err := cc.IssuerAltNames(); names != nil || err != nil {
+		t.Errorf("expected no issuerAltNames, got %v (error %v)", names, err)
+	}
+
+	unicode, err := cc.IdnaToUnicode("xn--bcher-kva")
+	if err != nil || unicode != "bücher" {
+		t.Errorf("expected bücher, got %q (error %v)", unicode, err)
+	}
+}
+
+// contextOnlyLint only applies when run with a CertificateContext.
+type contextOnlyLint struct{}
+
+func (l *contextOnlyLint) CheckApplies(c *x509.Certificate) bool {
+	return false
+}
+
+func (l *contextOnlyLint) Execute(c *x509.Certificate) *LintResult {
+	return &LintResult{Status: Error}
+}
+
+func (l *contextOnlyLint) CheckAppliesWithContext(cc *CertificateContext) bool {
+	return true
+}
+
+func (l *contextOnlyLint) ExecuteWithContext(cc *CertificateContext) *LintResult {
+	return &LintResult{Status: Pass}
+}
+
+func TestCertificateLintPrefersContext(t *testing.T) {
+	l := &CertificateLint{
+		LintMetadata: LintMetadata{Name: "e_context_only", Source: Community},
+		Lint:         func() CertificateLintInterface { return &contextOnlyLint{} },
+	}
+	result := l.Execute(readCertificate(t, "SANDNSDuplicate.pem"), NewEmptyConfig())
+	if result.Status != Pass {
+		t.Errorf("expected the context methods to be used, got %s", result.Status)
+	}
+}
@@ -29,6 +29,13 @@ import (
 // to hold the full TOML tree that is a physical ZLint configuration./
 type Configuration struct {
 	tree *toml.Tree
+	// lints holds the lint instances configured from tree. It is shared by
+	// every copy of the Configuration.
+	lints *configuredLints
+	// files holds the files, such as CT log lists, read for the higher scoped
+	// configurations of tree. It is shared by every copy of the
+	// Configuration.
+	files *configurationFiles
 }
 
 // MaybeConfigure is a thin wrapper over Configure.
@@ -97,7 +104,7 @@ func NewConfig(r io.Reader) (Configuration, error) {
 	if err != nil {
 		return Configuration{}, err
 	}
-	return Configuration{tree}, nil
+	return Configuration{tree: tree, lints: &configuredLints{}, files: &configurationFiles{}}, nil
 }
 
 // NewConfigFromFile attempts to instantiate a configuration from the provided filesystem path.
@@ -206,7 +213,17 @@ func (c Configuration) resolveHigherScopedReferences(i interface{}) error {
 			// This skips fields that are either not addressable or are private data members.
 			continue
 		}
-		if _, ok := field.Interface().(GlobalConfiguration); ok {
+		if global, ok := field.Interface().(GlobalConfiguration); ok && field.Kind() == reflect.Struct {
+			// It's one of our higher level configurations held by value, so its subtree
+			// is deserialized in place.
+			err := c.deserializeConfigInto(field.Addr().Interface(), global.namespace())
+			if err != nil {
+				return err
+			}
+			if binder, ok := field.Addr().Interface().(configurationBinder); ok {
+				binder.bind(c)
+			}
+		} else if ok {
 			// It's one of our higher level configurations, so we need to pull out a different
 			// subtree from our TOML document and inject it int othis struct.
 			config := initializePtr(field).Interface().(GlobalConfiguration)
@@ -214,6 +231,9 @@ func (c Configuration) resolveHigherScopedReferences(i interface{}) error {
 			if err != nil {
 				return err
 			}
+			if binder, ok := config.(configurationBinder); ok {
+				binder.bind(c)
+			}
 			field.Set(reflect.ValueOf(config))
 		} else {
 			// This is just another member of some kind that is not one of our higher level configurations.
@@ -0,0 +1,173 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// FileSettings returns the dotted TOML paths of the keys of the configuration
+// that name files to be read, such as "e_some_lint.Blocklist", as found by
+// matching the configuration against the lints of registry and the
+// GlobalConfiguration namespaces. These are the configuration fields tagged
+// `file:"true"`. Applications that accept configurations from less trusted
+// sources than their own can use FileSettings to refuse those that would have
+// files of their choosing read.
+func (c Configuration) FileSettings(registry Registry) []string {
+	targets := configurationTargets(registry)
+	var settings []string
+	var globals []string
+	for _, key := range c.tree.Keys() {
+		section, ok := c.tree.Get(key).(*toml.Tree)
+		if !ok {
+			// Keys outside of any section belong to the Global namespace.
+			globals = append(globals, key)
+			continue
+		}
+		if target := targets[key]; target != nil {
+			settings = append(settings, fileSettings(section, section.Keys(), target, key)...)
+		}
+	}
+	settings = append(settings, fileSettings(c.tree, globals, targets[Global{}.namespace()], "")...)
+	sort.Strings(settings)
+	return settings
+}
+
+// fileSettings returns the dotted paths of the given keys of tree, and of the
+// keys of any tables among them, that match fields of the struct that target
+// points to which are tagged `file:"true"`. path is the dotted path of tree
+// within the configuration.
+func fileSettings(tree *toml.Tree, keys []string, target interface{}, path string) []string {
+	targetType := reflect.TypeOf(target)
+	for targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+	if targetType.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := configurationFields(targetType)
+	var settings []string
+	for _, key := range keys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		field, ok := matchConfigurationField(fields, key)
+		if !ok {
+			continue
+		}
+		if field.file {
+			settings = append(settings, keyPath)
+		}
+		if subtree, ok := tree.Get(key).(*toml.Tree); ok {
+			settings = append(settings, fileSettings(subtree, subtree.Keys(), reflect.New(field.typ).Interface(), keyPath)...)
+		}
+	}
+	return settings
+}
+
+// configurationTargets maps the name of each lint of registry, and each
+// GlobalConfiguration namespace, to the value that its section is decoded
+// into, as well as the [Filter] section. Lints that are not Configurable map
+// to nil.
+func configurationTargets(registry Registry) map[string]interface{} {
+	targets := map[string]interface{}{}
+	add := func(name string, l interface{}) {
+		if configurable, ok := l.(Configurable); ok {
+			targets[name] = configurable.Configure()
+		} else {
+			targets[name] = nil
+		}
+	}
+	for _, l := range registry.CertificateLints().Lints() {
+		add(l.Name, l.Lint())
+	}
+	for _, l := range registry.RevocationListLints().Lints() {
+		add(l.Name, l.Lint())
+	}
+	for _, l := range registry.OcspResponseLints().Lints() {
+		add(l.Name, l.Lint())
+	}
+	for _, l := range RawCertificateLintsOf(registry) {
+		add(l.Name, l.Lint())
+	}
+	for _, l := range CertificateRequestLintsOf(registry) {
+		add(l.Name, l.Lint())
+	}
+	// EtsiEsiConfig is not among the defaultGlobals used for the example
+	// configuration, but is a namespace all the same.
+	for _, global := range append([]GlobalConfiguration{&EtsiEsiConfig{}}, defaultGlobals...) {
+		targets[global.namespace()] = global
+	}
+	targets[filterNamespace] = &FilterConfig{}
+	return targets
+}
+
+// configurationField is an exported field of a configuration struct.
+type configurationField struct {
+	// key is the TOML key of the field, which is its name unless it has
+	// a toml tag.
+	key string
+	typ reflect.Type
+	// file is set if the field is tagged `file:"true"`, as it names a file
+	// to be read.
+	file bool
+}
+
+func configurationFields(t reflect.Type) []configurationField {
+	var fields []configurationField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		key := field.Name
+		if tag, ok := field.Tag.Lookup("toml"); ok {
+			name := strings.Split(tag, ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name != "" {
+				key = name
+			}
+		}
+		fields = append(fields, configurationField{key: key, typ: field.Type, file: field.Tag.Get("file") == "true"})
+	}
+	return fields
+}
+
+// matchConfigurationField returns the field that the TOML key is decoded into.
+// As well as the exact key of a field, go-toml accepts its lower case, upper
+// case and lower camel case forms.
+func matchConfigurationField(fields []configurationField, key string) (configurationField, bool) {
+	for _, field := range fields {
+		keysToTry := []string{
+			field.key,
+			strings.ToLower(field.key),
+			strings.ToTitle(field.key),
+			strings.ToLower(field.key[:1]) + field.key[1:],
+		}
+		for _, k := range keysToTry {
+			if k == key {
+				return field, true
+			}
+		}
+	}
+	return configurationField{}, false
+}
@@ -0,0 +1,96 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+type fileSettingsTestLint struct {
+	Rounds int
+	File   string `file:"true"`
+	Nested struct {
+		Files []string `file:"true"`
+	}
+}
+
+func (l *fileSettingsTestLint) Configure() interface{} {
+	return l
+}
+
+func (l *fileSettingsTestLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *fileSettingsTestLint) Execute(c *x509.Certificate) *LintResult {
+	return &LintResult{Status: Pass}
+}
+
+func TestConfigurationFileSettings(t *testing.T) {
+	registry, err := NewRegistryBuilder().
+		AddCertificateLint(&CertificateLint{
+			LintMetadata: LintMetadata{Name: "e_file_settings_test_lint", Source: Community},
+			Lint:         func() CertificateLintInterface { return &fileSettingsTestLint{} },
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := NewConfigFromString(`
+[e_file_settings_test_lint]
+Rounds = 5
+file = "/etc/zlint/file"
+
+[e_file_settings_test_lint.Nested]
+Files = ["/etc/zlint/nested"]
+
+[CABFBaselineRequirementsConfig]
+[ChromeRootProgramPolicyConfig]
+ctloglist = "/etc/zlint/log_list.json"
+
+[GTLDConfig]
+GTLDSnapshot = "/etc/zlint/gtld_snapshot.json"
+
+[IssuerCertificatesConfig]
+IssuerCertificates = "/etc/zlint/issuers.pem"
+
+[KeyBlocklistConfig]
+DebianWeakKeys = ["/usr/share/openssl-blacklist/blacklist.RSA-2048"]
+
+[PublicSuffixListConfig]
+PublicSuffixList = "/etc/zlint/public_suffix_list.dat"
+
+[e_no_such_lint]
+File = "/etc/zlint/ignored"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{
+		"ChromeRootProgramPolicyConfig.ctloglist",
+		"GTLDConfig.GTLDSnapshot",
+		"IssuerCertificatesConfig.IssuerCertificates",
+		"KeyBlocklistConfig.DebianWeakKeys",
+		"PublicSuffixListConfig.PublicSuffixList",
+		"e_file_settings_test_lint.Nested.Files",
+		"e_file_settings_test_lint.file",
+	}
+	if settings := config.FileSettings(registry); !reflect.DeepEqual(settings, expect) {
+		t.Errorf("expected file settings %q, got %q", expect, settings)
+	}
+}
@@ -19,9 +19,12 @@ import (
 	"os"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/pelletier/go-toml"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
 )
 
 func TestInt(t *testing.T) {
@@ -630,11 +633,163 @@ func TestEmbedAppleRootStorePolicyConfig(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if !reflect.DeepEqual(test, Test{AppleRootStorePolicyConfig: AppleRootStorePolicyConfig{}, SomethingElse: "cool"}) {
+	if !reflect.DeepEqual(test, Test{AppleRootStorePolicyConfig: AppleRootStorePolicyConfig{files: c.files}, SomethingElse: "cool"}) {
 		t.Fatalf("wanted  Test{AppleRootStorePolicyConfig: AppleRootStorePolicyConfig{}, SomethingElse: \"cool\"}} got %v", test)
 	}
 }
 
+func TestKeyBlocklistConfigReadOncePerConfiguration(t *testing.T) {
+	type Test struct {
+		KeyBlocklistConfig KeyBlocklistConfig
+	}
+	config := `
+    [KeyBlocklistConfig]
+    DebianWeakKeys = ["../testdata/debianWeakKeys.txt"]
+    CompromisedKeys = ["../testdata/compromisedKeys.txt"]
+    `
+	read := func(c Configuration) (*util.DebianWeakKeys, *util.CompromisedKeys) {
+		test := Test{}
+		if err := c.Configure(&test, "Test"); err != nil {
+			t.Fatal(err)
+		}
+		debian, err := test.KeyBlocklistConfig.DebianWeakKeyBlocklist()
+		if err != nil {
+			t.Fatal(err)
+		}
+		compromised, err := test.KeyBlocklistConfig.CompromisedKeyBlocklist()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return debian, compromised
+	}
+	c1, err := NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	debian, compromised := read(c1)
+	if debian.Len() != 4 || compromised.Len() != 2 {
+		t.Fatalf("expected 4 Debian weak keys and 2 compromised keys, got %d and %d", debian.Len(), compromised.Len())
+	}
+	if again, againCompromised := read(c1); again != debian || againCompromised != compromised {
+		t.Error("expected the blocklists to be read once per Configuration")
+	}
+	if other, otherCompromised := read(c2); other == debian || otherCompromised == compromised {
+		t.Error("expected the blocklists to be read for each Configuration")
+	}
+	unset, err := KeyBlocklistConfig{}.DebianWeakKeyBlocklist()
+	if unset != nil || err != nil {
+		t.Errorf("expected no blocklist when DebianWeakKeys is not set, got %v, %v", unset, err)
+	}
+}
+
+func TestConfigurationFilesReadOncePerConfiguration(t *testing.T) {
+	type Test struct {
+		AppleRootStorePolicyConfig    AppleRootStorePolicyConfig
+		ChromeRootProgramPolicyConfig ChromeRootProgramPolicyConfig
+		PublicSuffixListConfig        PublicSuffixListConfig
+		GTLDConfig                    GTLDConfig
+		IssuerCertificatesConfig      IssuerCertificatesConfig
+	}
+	config := `
+    [AppleRootStorePolicyConfig]
+    CTLogList = "../testdata/ctLogList.json"
+
+    [ChromeRootProgramPolicyConfig]
+    CTLogList = "../testdata/ctLogList.json"
+
+    [PublicSuffixListConfig]
+    PublicSuffixList = "../testdata/publicSuffixList.dat"
+
+    [GTLDConfig]
+    GTLDSnapshot = "../testdata/gtldSnapshot.json"
+
+    [IssuerCertificatesConfig]
+    IssuerCertificates = "../testdata/sctIssuer.pem"
+    `
+	read := func(c Configuration) []interface{} {
+		test := Test{}
+		if err := c.Configure(&test, "Test"); err != nil {
+			t.Fatal(err)
+		}
+		apple, err := test.AppleRootStorePolicyConfig.LogList()
+		if err != nil {
+			t.Fatal(err)
+		}
+		chrome, err := test.ChromeRootProgramPolicyConfig.LogList()
+		if err != nil {
+			t.Fatal(err)
+		}
+		psl, err := test.PublicSuffixListConfig.List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tlds, err := test.GTLDConfig.TLDMap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		issuers, err := test.IssuerCertificatesConfig.Issuers()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(issuers) == 0 {
+			t.Fatal("expected issuer certificates")
+		}
+		return []interface{}{apple, chrome, psl, tlds, &issuers[0]}
+	}
+	c1, err := NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := NewConfigFromString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := read(c1)
+	if first[0] != first[1] {
+		t.Error("expected a CT log list to be read once for both root programs")
+	}
+	again := read(c1)
+	other := read(c2)
+	for i := range first {
+		if again[i] != first[i] {
+			t.Errorf("file %d: expected it to be read once per Configuration", i)
+		}
+		if other[i] == first[i] {
+			t.Errorf("file %d: expected it to be read for each Configuration", i)
+		}
+	}
+}
+
+func TestEmbedChromeRootProgramPolicyConfig(t *testing.T) {
+	type Test struct {
+		ChromeRootProgramPolicyConfig ChromeRootProgramPolicyConfig
+		SomethingElse                 string
+	}
+	c, err := NewConfigFromString(`
+    [ChromeRootProgramPolicyConfig]
+    CTLogList = "log_list.json"
+
+    [Test]
+    SomethingElse = "cool"
+    `)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := Test{}
+	err = c.Configure(&test, "Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Test{ChromeRootProgramPolicyConfig: ChromeRootProgramPolicyConfig{CTLogList: "log_list.json", files: c.files}, SomethingElse: "cool"}
+	if !reflect.DeepEqual(test, want) {
+		t.Fatalf("wanted %v got %v", want, test)
+	}
+}
+
 func TestEmbedCommunityConfig(t *testing.T) {
 	type Test struct {
 		CommunityConfig CommunityConfig
@@ -850,7 +1005,7 @@ func TestEmbedPtrToAppleRootStorePolicyConfig(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if !reflect.DeepEqual(test, Test{AppleRootStorePolicyConfig: &AppleRootStorePolicyConfig{}, SomethingElse: "cool"}) {
+	if !reflect.DeepEqual(test, Test{AppleRootStorePolicyConfig: &AppleRootStorePolicyConfig{files: c.files}, SomethingElse: "cool"}) {
 		t.Fatalf("wanted  Test{AppleRootStorePolicyConfig: &AppleRootStorePolicyConfig{}, SomethingElse: \"cool\"}} got %v", test)
 	}
 }
@@ -932,15 +1087,49 @@ func TestPrintConfiguration(t *testing.T) {
 	// out the configuration file.
 	want := `
 [AppleRootStorePolicyConfig]
+# The path to a local copy of Apple's CT log list (https://valid.apple.com/ct/log_list/current_log_list.json). When set, embedded SCTs are checked against the operators and states of the logs trusted by Apple
+CTLogList = ""
 
 [CABFBaselineRequirementsConfig]
+# The signature algorithms that the CA uses, by name (such as SHA256-RSA or ECDSA-SHA384) or OID. When set, any other signature algorithm is reported
+AcceptedSignatureAlgorithms = []
+# Domains internal to the CA's organization, such as corp.example. Names within these domains are not required to have a valid TLD when PrivateHierarchy is set
+InternalDomains = []
+# The OIDs of the certificate policies of the CA. When set, subscriber certificates that assert any other policy, other than a CA/Browser Forum reserved or EV policy, are reported
+PolicyOIDs = []
+# Set when the certificates being linted do not chain to a publicly trusted root, in which case they are not subject to the requirements on publicly trusted certificates that are relaxed by this configuration
+PrivateHierarchy = false
 
 [CABFEVGuidelinesConfig]
+# The OIDs of the EV certificate policies of the CA, in addition to the CA/Browser Forum EV OID and the EV OIDs known to ZLint
+EVPolicyOIDs = []
+
+[ChromeRootProgramPolicyConfig]
+# The path to a local copy of Chrome's CT log list (https://www.gstatic.com/ct/log_list/v3/log_list.json). When set, embedded SCTs are checked against the operators and states of the logs trusted by Chrome
+CTLogList = ""
 
 [CommunityConfig]
 
+[GTLDConfig]
+# The path to a snapshot of the top-level domains and their validity periods, as written by zlint-gtld-update -json. When set, it is used in place of the snapshot built into ZLint
+GTLDSnapshot = ""
+
+[IssuerCertificatesConfig]
+# The path to a PEM file of the certificates that may have issued the certificates being linted. When set, along with a CT log list, the signatures of embedded SCTs are verified
+IssuerCertificates = ""
+
+[KeyBlocklistConfig]
+# Paths to blocklists of compromised keys, in which each line is the hexadecimal SHA-256 hash of the DER encoded SubjectPublicKeyInfo of a key
+CompromisedKeys = []
+# Paths to blocklists of the RSA keys generated by the Debian OpenSSL package with a predictable random number generator, in the format of the openssl-blacklist package, such as /usr/share/openssl-blacklist/blacklist.RSA-2048
+DebianWeakKeys = []
+
 [MozillaRootStorePolicyConfig]
 
+[PublicSuffixListConfig]
+# The path to a local copy of the Public Suffix List (https://publicsuffix.org/list/public_suffix_list.dat). When set, it is used in place of the snapshot of the list built into ZLint
+PublicSuffixList = ""
+
 [RFC5280Config]
 
 [RFC5480Config]
@@ -1186,3 +1375,122 @@ func TestFailedToOpenConfigFile(t *testing.T) {
 		t.Fatalf("expected an error got %v", c)
 	}
 }
+
+type countingLint struct {
+	Threshold int
+}
+
+func (l *countingLint) Configure() interface{} {
+	return l
+}
+
+func (l *countingLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *countingLint) Execute(c *x509.Certificate) *LintResult {
+	if l.Threshold > 1 {
+		return &LintResult{Status: Warn}
+	}
+	return &LintResult{Status: Pass}
+}
+
+func TestConfiguredLintsAreReused(t *testing.T) {
+	var constructed int32
+	l := &CertificateLint{
+		LintMetadata: LintMetadata{Name: "w_counting_lint", Source: Community},
+		Lint: func() CertificateLintInterface {
+			atomic.AddInt32(&constructed, 1)
+			return &countingLint{}
+		},
+	}
+	registry := NewRegistry()
+	if err := registry.registerCertificateLint(l); err != nil {
+		t.Fatal(err)
+	}
+	// Registration constructs the lint once to check that it is not nil.
+	atomic.StoreInt32(&constructed, 0)
+	c, err := NewConfigFromString(`
+[w_counting_lint]
+Threshold = 2`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.SetConfiguration(c)
+	// SetConfiguration configures the lint ahead of any execution.
+	if got := atomic.LoadInt32(&constructed); got != 1 {
+		t.Fatalf("expected the lint to be constructed once by SetConfiguration, got %d", got)
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := l.Execute(&x509.Certificate{}, registry.GetConfiguration())
+			if result.Status != Warn {
+				t.Errorf("expected the configured lint to warn, got %s", result.Status)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := atomic.LoadInt32(&constructed); got != 1 {
+		t.Errorf("expected the lint to be constructed once, got %d", got)
+	}
+
+	filtered, err := registry.Filter(FilterOptions{IncludeNames: []string{"w_counting_lint"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&constructed, 0)
+	filtered.CertificateLints().ByName("w_counting_lint").Execute(&x509.Certificate{}, filtered.GetConfiguration())
+	if got := atomic.LoadInt32(&constructed); got != 0 {
+		t.Errorf("expected the filtered registry to share the configured lint, got %d constructions", got)
+	}
+}
+
+func TestEmbedPopulatedGlobalByValue(t *testing.T) {
+	type Test struct {
+		CABFEVGuidelinesConfig CABFEVGuidelinesConfig
+		SomethingElse          string
+	}
+	c, err := NewConfigFromString(`
+    [CABFEVGuidelinesConfig]
+    EVPolicyOIDs = ["1.2.3.4"]
+
+    [Test]
+    SomethingElse = "cool"
+    `)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := Test{}
+	err = c.Configure(&test, "Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Test{CABFEVGuidelinesConfig: CABFEVGuidelinesConfig{EVPolicyOIDs: []string{"1.2.3.4"}}, SomethingElse: "cool"}
+	if !reflect.DeepEqual(test, want) {
+		t.Fatalf("wanted %v got %v", want, test)
+	}
+}
+
+func TestConfiguredLintsSharingANameAreDistinct(t *testing.T) {
+	// countingLint warns when its Threshold is above 1.
+	newLint := func(threshold int) *CertificateLint {
+		return &CertificateLint{
+			LintMetadata: LintMetadata{Name: "w_shared_name_lint", Source: Community},
+			Lint: func() CertificateLintInterface {
+				return &countingLint{Threshold: threshold}
+			},
+		}
+	}
+	passing, warning := newLint(1), newLint(2)
+	c := NewEmptyConfig()
+	if result := passing.Execute(&x509.Certificate{}, c); result.Status != Pass {
+		t.Errorf("expected the first lint to pass, got %s", result.Status)
+	}
+	if result := warning.Execute(&x509.Certificate{}, c); result.Status != Warn {
+		t.Errorf("expected the second lint, of the same name, to warn, got %s", result.Status)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// ConfigurationProblem describes a part of a Configuration that has no effect
+// or that can not be applied, as found by Configuration.Validate.
+type ConfigurationProblem struct {
+	// Path is the dotted TOML path of the section or key, such as
+	// "e_rsa_fermat_factorization.Rounds".
+	Path string
+	// Line is the line of the configuration at which the section or key
+	// appears, or 0 if it is not known.
+	Line int
+	// Description explains the problem, including any suggestion of what may
+	// have been meant.
+	Description string
+}
+
+func (p ConfigurationProblem) String() string {
+	if p.Line == 0 {
+		return fmt.Sprintf("%s: %s", p.Path, p.Description)
+	}
+	return fmt.Sprintf("line %d: %s: %s", p.Line, p.Path, p.Description)
+}
+
+// Validate checks the configuration against the lints of registry and the
+// GlobalConfiguration namespaces, which would otherwise silently ignore
+// anything that they do not expect. It reports sections that name no lint or
+// namespace and are not the [Filter] section, keys that match no field of the
+// configuration of their lint or namespace, and values that can not be
+// decoded into the field that they match. Problems are ordered by their
+// position in the configuration.
+func (c Configuration) Validate(registry Registry) []ConfigurationProblem {
+	targets := configurationTargets(registry)
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var problems []ConfigurationProblem
+	var globals []string
+	for _, key := range c.tree.Keys() {
+		section, ok := c.tree.Get(key).(*toml.Tree)
+		if !ok {
+			// Keys outside of any section belong to the Global namespace.
+			globals = append(globals, key)
+			continue
+		}
+		target, ok := targets[key]
+		switch {
+		case !ok:
+			problems = append(problems, ConfigurationProblem{
+				Path:        key,
+				Line:        c.tree.GetPosition(key).Line,
+				Description: "section matches no lint or global configuration" + suggestion(key, names),
+			})
+		case target == nil:
+			problems = append(problems, ConfigurationProblem{
+				Path:        key,
+				Line:        c.tree.GetPosition(key).Line,
+				Description: "lint is not configurable",
+			})
+		default:
+			problems = append(problems, validateSection(section, section.Keys(), target, key, c.tree.GetPosition(key).Line)...)
+		}
+	}
+	problems = append(problems, validateSection(c.tree, globals, targets[Global{}.namespace()], "", 0)...)
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+	return problems
+}
+
+// validateSection checks the given keys of tree against the fields of the
+// struct that target points to. path is the dotted path of tree within the
+// configuration, and line is the line at which it appears.
+func validateSection(tree *toml.Tree, keys []string, target interface{}, path string, line int) []ConfigurationProblem {
+	targetType := reflect.TypeOf(target)
+	for targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+	if targetType.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := configurationFields(targetType)
+	fieldNames := make([]string, 0, len(fields))
+	for _, field := range fields {
+		fieldNames = append(fieldNames, field.key)
+	}
+
+	var problems []ConfigurationProblem
+	for _, key := range keys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		// go-toml does not record the positions of inline tables or of the
+		// keys within them, which are instead attributed to the line of the
+		// enclosing section.
+		keyLine := line
+		if position := tree.GetPosition(key); position.Col != 0 {
+			keyLine = position.Line
+		}
+		field, ok := matchConfigurationField(fields, key)
+		if !ok {
+			problems = append(problems, ConfigurationProblem{
+				Path:        keyPath,
+				Line:        keyLine,
+				Description: "key matches no configuration field" + suggestion(key, fieldNames),
+			})
+			continue
+		}
+		// Decode the key on its own, so that every mismatch is reported
+		// rather than only the first.
+		value := tree.Get(key)
+		single, err := toml.TreeFromMap(map[string]interface{}{key: plainValue(value)})
+		if err == nil {
+			err = single.Unmarshal(reflect.New(targetType).Interface())
+		}
+		if err != nil {
+			// The position of the error within the single key tree is
+			// meaningless, and so is dropped in favour of keyLine.
+			problems = append(problems, ConfigurationProblem{
+				Path:        keyPath,
+				Line:        keyLine,
+				Description: "value can not be used: " + strings.TrimPrefix(err.Error(), "(0, 0): "),
+			})
+			continue
+		}
+		if subtree, ok := value.(*toml.Tree); ok {
+			problems = append(problems, validateSection(subtree, subtree.Keys(), reflect.New(field.typ).Interface(), keyPath, keyLine)...)
+		}
+	}
+	return problems
+}
+
+// plainValue converts the tables within a value of a toml.Tree into maps, as
+// toml.TreeFromMap does not accept trees.
+func plainValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *toml.Tree:
+		return v.ToMap()
+	case []*toml.Tree:
+		tables := make([]interface{}, 0, len(v))
+		for _, table := range v {
+			tables = append(tables, table.ToMap())
+		}
+		return tables
+	default:
+		return value
+	}
+}
+
+// suggestion returns a suggestion of the candidate closest to name, or the
+// empty string if no candidate is close enough to be a likely misspelling.
+func suggestion(name string, candidates []string) string {
+	best, bestDistance := "", len(name)/3+2
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %q?)", best)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,155 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+type validationTestLint struct {
+	Rounds  int
+	Names   []string `toml:"names"`
+	Nested  validationTestNested
+	Ignored int `toml:"-"`
+	Globals *CABFBaselineRequirementsConfig
+}
+
+type validationTestNested struct {
+	Enabled bool
+}
+
+func (l *validationTestLint) Configure() interface{} {
+	return l
+}
+
+func (l *validationTestLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *validationTestLint) Execute(c *x509.Certificate) *LintResult {
+	return &LintResult{Status: Pass}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	registry, err := NewRegistryBuilder().
+		AddCertificateLint(&CertificateLint{
+			LintMetadata: LintMetadata{Name: "e_validation_test_lint", Source: Community},
+			Lint:         func() CertificateLintInterface { return &validationTestLint{} },
+		}).
+		AddCertificateLint(&CertificateLint{
+			LintMetadata: LintMetadata{Name: "e_validation_test_unconfigurable", Source: Community},
+			Lint:         func() CertificateLintInterface { return &PanicLint{} },
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		Config string
+		Expect []string
+	}{
+		{
+			Name: "valid",
+			Config: `
+[e_validation_test_lint]
+Rounds = 5
+names = ["a"]
+rounds = 6
+
+[e_validation_test_lint.Nested]
+Enabled = true
+
+[CABFBaselineRequirementsConfig]
+
+[EtsiEsiConfig]
+`,
+		},
+		{
+			Name: "unknown section",
+			Config: `
+[e_validation_test_lnt]
+Rounds = 5
+
+[e_something_else_entirely]
+`,
+			Expect: []string{
+				`line 2: e_validation_test_lnt: section matches no lint or global configuration (did you mean "e_validation_test_lint"?)`,
+				`line 5: e_something_else_entirely: section matches no lint or global configuration`,
+			},
+		},
+		{
+			Name: "unknown keys",
+			Config: `
+Stray = 1
+
+[e_validation_test_lint]
+Round = 5
+Ignored = 1
+Nested = { Enable = true }
+`,
+			Expect: []string{
+				`line 2: Stray: key matches no configuration field`,
+				// Keys within inline tables are reported at the line of
+				// their section.
+				`line 4: e_validation_test_lint.Nested.Enable: key matches no configuration field (did you mean "Enabled"?)`,
+				`line 5: e_validation_test_lint.Round: key matches no configuration field (did you mean "Rounds"?)`,
+				`line 6: e_validation_test_lint.Ignored: key matches no configuration field`,
+			},
+		},
+		{
+			Name: "type mismatches",
+			Config: `
+[e_validation_test_lint]
+Rounds = "five"
+names = 1
+`,
+			Expect: []string{
+				`line 3: e_validation_test_lint.Rounds: value can not be used: Can't convert five(string) to int`,
+				`line 4: e_validation_test_lint.names: value can not be used: Can't convert 1(int64) to []string(slice)`,
+			},
+		},
+		{
+			Name: "not configurable",
+			Config: `
+[e_validation_test_unconfigurable]
+A = 1
+`,
+			Expect: []string{
+				`line 2: e_validation_test_unconfigurable: lint is not configurable`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			config, err := NewConfigFromString(tc.Config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, problem := range config.Validate(registry) {
+				got = append(got, problem.String())
+			}
+			if !reflect.DeepEqual(got, tc.Expect) {
+				t.Errorf("expected problems %q, got %q", tc.Expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import "sync"
+
+// configuredLint is a lint instance that has been configured from a
+// Configuration, along with any error that occurred while configuring it.
+type configuredLint struct {
+	lint interface{}
+	err  error
+}
+
+// configuredLints caches the lint instances configured from a single
+// Configuration, so that a lint's section of the TOML tree is deserialized,
+// and its higher scoped references resolved, once per Configuration rather
+// than once per linted input.
+//
+// Cached instances are shared by every goroutine executing lints with the
+// Configuration, which is why lint implementations must not modify their
+// receivers in CheckApplies or Execute.
+type configuredLints struct {
+	lints sync.Map
+}
+
+// configured returns the instance of the lint l, with the given name, that
+// has been constructed by newLint and configured from c. The instance is
+// constructed and configured on first use and then reused for the lifetime of
+// c.
+//
+// Instances are cached by l, the pointer to the registered lint, so that
+// distinct lints that share a name, such as those of different registries
+// configured by the same Configuration, never share an instance.
+func (c Configuration) configured(l interface{}, name string, newLint func() interface{}) (interface{}, error) {
+	if c.lints == nil {
+		lint := newLint()
+		return lint, c.MaybeConfigure(lint, name)
+	}
+	if cached, ok := c.lints.lints.Load(l); ok {
+		return cached.(*configuredLint).lint, cached.(*configuredLint).err
+	}
+	lint := newLint()
+	err := c.MaybeConfigure(lint, name)
+	cached, _ := c.lints.lints.LoadOrStore(l, &configuredLint{lint: lint, err: err})
+	return cached.(*configuredLint).lint, cached.(*configuredLint).err
+}
+
+// configuredCertificateLint returns the configured instance of l.
+func (c Configuration) configuredCertificateLint(l *CertificateLint) (CertificateLintInterface, error) {
+	lint, err := c.configured(l, l.Name, func() interface{} { return l.Lint() })
+	if err != nil {
+		return nil, err
+	}
+	return lint.(CertificateLintInterface), nil
+}
+
+// configuredRevocationListLint returns the configured instance of l.
+func (c Configuration) configuredRevocationListLint(l *RevocationListLint) (RevocationListLintInterface, error) {
+	lint, err := c.configured(l, l.Name, func() interface{} { return l.Lint() })
+	if err != nil {
+		return nil, err
+	}
+	return lint.(RevocationListLintInterface), nil
+}
+
+// configuredOcspResponseLint returns the configured instance of l.
+func (c Configuration) configuredOcspResponseLint(l *OcspResponseLint) (OcspResponseLintInterface, error) {
+	lint, err := c.configured(l, l.Name, func() interface{} { return l.Lint() })
+	if err != nil {
+		return nil, err
+	}
+	return lint.(OcspResponseLintInterface), nil
+}
+
+// configuredRawCertificateLint returns the configured instance of l.
+func (c Configuration) configuredRawCertificateLint(l *RawCertificateLint) (RawCertificateLintInterface, error) {
+	lint, err := c.configured(l, l.Name, func() interface{} { return l.Lint() })
+	if err != nil {
+		return nil, err
+	}
+	return lint.(RawCertificateLintInterface), nil
+}
+
+// configuredCertificateRequestLint returns the configured instance of l.
+func (c Configuration) configuredCertificateRequestLint(l *CertificateRequestLint) (CertificateRequestLintInterface, error) {
+	lint, err := c.configured(l, l.Name, func() interface{} { return l.Lint() })
+	if err != nil {
+		return nil, err
+	}
+	return lint.(CertificateRequestLintInterface), nil
+}
@@ -0,0 +1,177 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// filterNamespace is the section of a configuration that is decoded into
+// a FilterConfig.
+const filterNamespace = "Filter"
+
+// FilterConfig selects the lints to run from within a configuration, so that
+// a single configuration can describe both which lints run and how they are
+// configured. It is decoded from the [Filter] section of a configuration, for
+// example...
+//
+// ```
+//
+//	[Filter]
+//	IncludeSources = ["RFC5280", "CABF_BR"]
+//	ExcludeNames = ["e_rsa_fermat_factorization"]
+//
+// ```
+//
+// The fields correspond to the zlint command line flags of the same names,
+// and to the fields of FilterOptions.
+type FilterConfig struct {
+	NameFilter     string
+	IncludeNames   []string
+	ExcludeNames   []string
+	IncludeSources []string
+	ExcludeSources []string
+	Profile        string
+}
+
+// Options returns the FilterOptions described by f. An error is returned if
+// NameFilter is not a valid regular expression, or if any of the sources or
+// the profile are unknown.
+func (f FilterConfig) Options() (FilterOptions, error) {
+	opts := FilterOptions{
+		IncludeNames: f.IncludeNames,
+		ExcludeNames: f.ExcludeNames,
+	}
+	if f.NameFilter != "" {
+		r, err := regexp.Compile(f.NameFilter)
+		if err != nil {
+			return FilterOptions{}, fmt.Errorf("bad NameFilter: %w", err)
+		}
+		opts.NameFilter = r
+	}
+	var err error
+	if opts.IncludeSources, err = sourceListOf(f.IncludeSources); err != nil {
+		return FilterOptions{}, fmt.Errorf("invalid IncludeSources: %w", err)
+	}
+	if opts.ExcludeSources, err = sourceListOf(f.ExcludeSources); err != nil {
+		return FilterOptions{}, fmt.Errorf("invalid ExcludeSources: %w", err)
+	}
+	if f.Profile != "" {
+		profile, ok := GetProfile(f.Profile)
+		if !ok {
+			return FilterOptions{}, fmt.Errorf("lint profile name does not exist: %v", f.Profile)
+		}
+		opts.AddProfile(profile)
+	}
+	return opts, nil
+}
+
+// FilterFlags holds the values of the zlint command line flags that select
+// lints, each of which is empty if the flag is not set. The lists are comma
+// separated.
+type FilterFlags struct {
+	NameFilter     string
+	IncludeNames   string
+	ExcludeNames   string
+	IncludeSources string
+	ExcludeSources string
+	Profile        string
+}
+
+// Override returns f with each setting replaced by that of the corresponding
+// flag, for each of flags that is set.
+func (f FilterConfig) Override(flags FilterFlags) FilterConfig {
+	if flags.NameFilter != "" {
+		f.NameFilter = flags.NameFilter
+	}
+	if flags.IncludeNames != "" {
+		f.IncludeNames = trimmedList(flags.IncludeNames)
+	}
+	if flags.ExcludeNames != "" {
+		f.ExcludeNames = trimmedList(flags.ExcludeNames)
+	}
+	if flags.IncludeSources != "" {
+		f.IncludeSources = trimmedList(flags.IncludeSources)
+	}
+	if flags.ExcludeSources != "" {
+		f.ExcludeSources = trimmedList(flags.ExcludeSources)
+	}
+	if flags.Profile != "" {
+		f.Profile = flags.Profile
+	}
+	return f
+}
+
+// trimmedList takes a comma separated string argument in raw, splits it by
+// comma, and returns a list of the separated elements after trimming spaces
+// from each element.
+func trimmedList(raw string) []string {
+	var list []string
+	for _, item := range strings.Split(raw, ",") {
+		list = append(list, strings.TrimSpace(item))
+	}
+	return list
+}
+
+func sourceListOf(sources []string) (SourceList, error) {
+	var list SourceList
+	for _, source := range sources {
+		if strings.TrimSpace(source) == "" {
+			continue
+		}
+		var s LintSource
+		s.FromString(source)
+		if s == UnknownLintSource {
+			return nil, fmt.Errorf("unknown lint source in list: %q", source)
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+// FilterConfig returns the [Filter] section of the configuration, which is
+// empty if the configuration has no such section.
+func (c Configuration) FilterConfig() (FilterConfig, error) {
+	var f FilterConfig
+	if err := c.Configure(&f, filterNamespace); err != nil {
+		return FilterConfig{}, err
+	}
+	return f, nil
+}
+
+// Registry returns a registry of the globally registered lints that are
+// selected by the [Filter] section of the configuration, configured by the
+// configuration. The registry is independent of the global registry, as if
+// built by a RegistryBuilder.
+func (c Configuration) Registry() (Registry, error) {
+	f, err := c.FilterConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts, err := f.Options()
+	if err != nil {
+		return nil, err
+	}
+	filtered, err := GlobalRegistry().Filter(opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistryBuilder().
+		ImportNames(filtered.Names()...).
+		WithConfiguration(c).
+		Build()
+}
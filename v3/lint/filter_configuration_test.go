@@ -0,0 +1,129 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterConfigOptions(t *testing.T) {
+	f := FilterConfig{
+		NameFilter:     "^e_",
+		ExcludeNames:   []string{"e_a"},
+		IncludeSources: []string{"RFC5280", " CABF_BR", ""},
+		ExcludeSources: []string{"ETSI_ESI"},
+	}
+	opts, err := f.Options()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.NameFilter.String() != "^e_" {
+		t.Errorf("expected NameFilter ^e_, got %v", opts.NameFilter)
+	}
+	if !reflect.DeepEqual(opts.ExcludeNames, []string{"e_a"}) {
+		t.Errorf("expected ExcludeNames [e_a], got %v", opts.ExcludeNames)
+	}
+	if !reflect.DeepEqual(opts.IncludeSources, SourceList{RFC5280, CABFBaselineRequirements}) {
+		t.Errorf("expected IncludeSources [RFC5280 CABF_BR], got %v", opts.IncludeSources)
+	}
+	if !reflect.DeepEqual(opts.ExcludeSources, SourceList{EtsiEsi}) {
+		t.Errorf("expected ExcludeSources [ETSI_ESI], got %v", opts.ExcludeSources)
+	}
+
+	for _, bad := range []FilterConfig{
+		{NameFilter: "("},
+		{IncludeSources: []string{"NotASource"}},
+		{ExcludeSources: []string{"NotASource"}},
+		{Profile: "not_a_profile"},
+	} {
+		if _, err := bad.Options(); err == nil {
+			t.Errorf("expected an error for %+v", bad)
+		}
+	}
+}
+
+func TestFilterConfigOverride(t *testing.T) {
+	f := FilterConfig{
+		NameFilter:     "^e_",
+		IncludeNames:   []string{"e_a"},
+		ExcludeSources: []string{"ETSI_ESI"},
+	}
+	got := f.Override(FilterFlags{
+		IncludeNames:   "e_b, e_c",
+		IncludeSources: "RFC5280",
+	})
+	expected := FilterConfig{
+		NameFilter:     "^e_",
+		IncludeNames:   []string{"e_b", "e_c"},
+		IncludeSources: []string{"RFC5280"},
+		ExcludeSources: []string{"ETSI_ESI"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+	if !reflect.DeepEqual(f.Override(FilterFlags{}), f) {
+		t.Errorf("expected no flags to leave %+v unchanged", f)
+	}
+}
+
+func TestConfigurationRegistry(t *testing.T) {
+	withGlobalRegistry(t,
+		mockCertificateLint("e_rfc_one", RFC5280),
+		mockCertificateLint("e_rfc_two", RFC5280),
+		mockCertificateLint("e_br_one", CABFBaselineRequirements),
+	)
+
+	// A configuration without a [Filter] section selects every lint.
+	registry, err := NewEmptyConfig().Registry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"e_br_one", "e_rfc_one", "e_rfc_two"}
+	if names := registry.Names(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected lints %v, got %v", expected, names)
+	}
+
+	config, err := NewConfigFromString(`
+[Filter]
+IncludeSources = ["RFC5280"]
+ExcludeNames = ["e_rfc_two"]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err = config.Registry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"e_rfc_one"}
+	if names := registry.Names(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected lints %v, got %v", expected, names)
+	}
+	if problems := config.Validate(registry); len(problems) != 0 {
+		t.Errorf("expected the [Filter] section to be valid, got %v", problems)
+	}
+
+	config, err = NewConfigFromString(`
+[Filter]
+IncludeSources = ["NotASource"]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := config.Registry(); err == nil {
+		t.Error("expected an error for an unknown source")
+	}
+}
@@ -14,6 +14,15 @@
 
 package lint
 
+import (
+	"strings"
+	"sync"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
+)
+
 // Global is what one would intuitive think of as being the global context of the configuration file.
 // That is, given the following configuration...
 //
@@ -68,7 +77,49 @@ func (r RFC5891Config) namespace() string {
 // [CABFBaselineRequirementsConfig]
 // ...
 // ...
-type CABFBaselineRequirementsConfig struct{}
+//
+// It holds facts about the CA issuing the certificates being linted that lints
+// can not learn from the certificates themselves.
+type CABFBaselineRequirementsConfig struct {
+	PolicyOIDs                  []string `comment:"The OIDs of the certificate policies of the CA. When set, subscriber certificates that assert any other policy, other than a CA/Browser Forum reserved or EV policy, are reported"`
+	InternalDomains             []string `comment:"Domains internal to the CA's organization, such as corp.example. Names within these domains are not required to have a valid TLD when PrivateHierarchy is set"`
+	AcceptedSignatureAlgorithms []string `comment:"The signature algorithms that the CA uses, by name (such as SHA256-RSA or ECDSA-SHA384) or OID. When set, any other signature algorithm is reported"`
+	PrivateHierarchy            bool     `comment:"Set when the certificates being linted do not chain to a publicly trusted root, in which case they are not subject to the requirements on publicly trusted certificates that are relaxed by this configuration"`
+}
+
+// IsDeclaredPolicy reports whether oid is one of the PolicyOIDs of the CA.
+func (c CABFBaselineRequirementsConfig) IsDeclaredPolicy(oid asn1.ObjectIdentifier) bool {
+	return containsOID(c.PolicyOIDs, oid)
+}
+
+// IsInternalDomain reports whether the DNS name is, or is a subdomain of, one
+// of the InternalDomains of the CA.
+func (c CABFBaselineRequirementsConfig) IsInternalDomain(name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	for _, domain := range c.InternalDomains {
+		domain = strings.ToLower(strings.Trim(domain, "."))
+		if domain != "" && (name == domain || strings.HasSuffix(name, "."+domain)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsSignatureAlgorithm reports whether the signature algorithm alg, with
+// the given OID, is one of the AcceptedSignatureAlgorithms of the CA. Every
+// algorithm is accepted if AcceptedSignatureAlgorithms is not set.
+func (c CABFBaselineRequirementsConfig) AcceptsSignatureAlgorithm(alg x509.SignatureAlgorithm, oid asn1.ObjectIdentifier) bool {
+	if len(c.AcceptedSignatureAlgorithms) == 0 {
+		return true
+	}
+	for _, accepted := range c.AcceptedSignatureAlgorithms {
+		accepted = strings.TrimSpace(accepted)
+		if strings.EqualFold(accepted, alg.String()) || (oid != nil && accepted == oid.String()) {
+			return true
+		}
+	}
+	return false
+}
 
 func (c CABFBaselineRequirementsConfig) namespace() string {
 	return "CABFBaselineRequirementsConfig"
@@ -79,7 +130,26 @@ func (c CABFBaselineRequirementsConfig) namespace() string {
 // [CABFEVGuidelinesConfig]
 // ...
 // ...
-type CABFEVGuidelinesConfig struct{}
+//
+// It holds facts about the CA issuing the certificates being linted that lints
+// can not learn from the certificates themselves.
+type CABFEVGuidelinesConfig struct {
+	EVPolicyOIDs []string `comment:"The OIDs of the EV certificate policies of the CA, in addition to the CA/Browser Forum EV OID and the EV OIDs known to ZLint"`
+}
+
+// IsEV reports whether any of the policy OIDs is an EV policy OID, either one
+// known to ZLint or one of the EVPolicyOIDs of the CA.
+func (c CABFEVGuidelinesConfig) IsEV(oids []asn1.ObjectIdentifier) bool {
+	if util.IsEV(oids) {
+		return true
+	}
+	for _, oid := range oids {
+		if containsOID(c.EVPolicyOIDs, oid) {
+			return true
+		}
+	}
+	return false
+}
 
 func (c CABFEVGuidelinesConfig) namespace() string {
 	return "CABFEVGuidelinesConfig"
@@ -101,12 +171,226 @@ func (m MozillaRootStorePolicyConfig) namespace() string {
 // [AppleRootStorePolicyConfig]
 // ...
 // ...
-type AppleRootStorePolicyConfig struct{}
+type AppleRootStorePolicyConfig struct {
+	CTLogList string `file:"true" comment:"The path to a local copy of Apple's CT log list (https://valid.apple.com/ct/log_list/current_log_list.json). When set, embedded SCTs are checked against the operators and states of the logs trusted by Apple"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// LogList returns Apple's CT log list, or nil if CTLogList is not set.
+func (a AppleRootStorePolicyConfig) LogList() (*util.CTLogList, error) {
+	return a.files.loadCTLogList(a.CTLogList)
+}
+
+func (a *AppleRootStorePolicyConfig) bind(c Configuration) {
+	a.files = c.files
+}
 
 func (a AppleRootStorePolicyConfig) namespace() string {
 	return "AppleRootStorePolicyConfig"
 }
 
+// ChromeRootProgramPolicyConfig is the higher scoped configuration which services as the deserialization target for...
+//
+// [ChromeRootProgramPolicyConfig]
+// ...
+// ...
+type ChromeRootProgramPolicyConfig struct {
+	CTLogList string `file:"true" comment:"The path to a local copy of Chrome's CT log list (https://www.gstatic.com/ct/log_list/v3/log_list.json). When set, embedded SCTs are checked against the operators and states of the logs trusted by Chrome"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// LogList returns Chrome's CT log list, or nil if CTLogList is not set.
+func (c ChromeRootProgramPolicyConfig) LogList() (*util.CTLogList, error) {
+	return c.files.loadCTLogList(c.CTLogList)
+}
+
+func (c *ChromeRootProgramPolicyConfig) bind(config Configuration) {
+	c.files = config.files
+}
+
+func (c ChromeRootProgramPolicyConfig) namespace() string {
+	return "ChromeRootProgramPolicyConfig"
+}
+
+// KeyBlocklistConfig is the higher scoped configuration which services as the deserialization target for...
+//
+// [KeyBlocklistConfig]
+// ...
+// ...
+//
+// It lists the blocklists of weak and compromised keys that CAs must reject
+// per BR 6.1.1.3. Blocklists are read when first needed, once per
+// Configuration and so once per Registry.
+type KeyBlocklistConfig struct {
+	DebianWeakKeys  []string `file:"true" comment:"Paths to blocklists of the RSA keys generated by the Debian OpenSSL package with a predictable random number generator, in the format of the openssl-blacklist package, such as /usr/share/openssl-blacklist/blacklist.RSA-2048"`
+	CompromisedKeys []string `file:"true" comment:"Paths to blocklists of compromised keys, in which each line is the hexadecimal SHA-256 hash of the DER encoded SubjectPublicKeyInfo of a key"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// DebianWeakKeyBlocklist returns the union of the DebianWeakKeys blocklists,
+// or nil if DebianWeakKeys is not set.
+func (k KeyBlocklistConfig) DebianWeakKeyBlocklist() (*util.DebianWeakKeys, error) {
+	if len(k.DebianWeakKeys) == 0 {
+		return nil, nil
+	}
+	keys, err := k.files.load("DebianWeakKeys", k.DebianWeakKeys, func() (interface{}, error) {
+		return util.ReadDebianWeakKeys(k.DebianWeakKeys...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys.(*util.DebianWeakKeys), nil
+}
+
+// CompromisedKeyBlocklist returns the union of the CompromisedKeys
+// blocklists, or nil if CompromisedKeys is not set.
+func (k KeyBlocklistConfig) CompromisedKeyBlocklist() (*util.CompromisedKeys, error) {
+	if len(k.CompromisedKeys) == 0 {
+		return nil, nil
+	}
+	keys, err := k.files.load("CompromisedKeys", k.CompromisedKeys, func() (interface{}, error) {
+		return util.ReadCompromisedKeys(k.CompromisedKeys...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys.(*util.CompromisedKeys), nil
+}
+
+func (k *KeyBlocklistConfig) bind(c Configuration) {
+	k.files = c.files
+}
+
+func (k KeyBlocklistConfig) namespace() string {
+	return "KeyBlocklistConfig"
+}
+
+// PublicSuffixListConfig is the higher scoped configuration which services as the deserialization target for...
+//
+// [PublicSuffixListConfig]
+// ...
+// ...
+//
+// It selects the snapshot of the Public Suffix List used by the lints that
+// need to know the public suffix of a domain name.
+type PublicSuffixListConfig struct {
+	PublicSuffixList string `file:"true" comment:"The path to a local copy of the Public Suffix List (https://publicsuffix.org/list/public_suffix_list.dat). When set, it is used in place of the snapshot of the list built into ZLint"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// List returns the Public Suffix List at PublicSuffixList, or the snapshot
+// built into ZLint if PublicSuffixList is not set.
+func (p PublicSuffixListConfig) List() (*util.PublicSuffixList, error) {
+	if p.PublicSuffixList == "" {
+		return util.DefaultPublicSuffixList(), nil
+	}
+	list, err := p.files.load("PublicSuffixList", []string{p.PublicSuffixList}, func() (interface{}, error) {
+		return util.ReadPublicSuffixList(p.PublicSuffixList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*util.PublicSuffixList), nil
+}
+
+func (p *PublicSuffixListConfig) bind(c Configuration) {
+	p.files = c.files
+}
+
+func (p PublicSuffixListConfig) namespace() string {
+	return "PublicSuffixListConfig"
+}
+
+// GTLDConfig is the higher scoped configuration which services as the deserialization target for...
+//
+// [GTLDConfig]
+// ...
+// ...
+//
+// It selects the snapshot of the top-level domains and their validity periods
+// used by the lints that check whether a domain name has a valid TLD.
+type GTLDConfig struct {
+	GTLDSnapshot string `file:"true" comment:"The path to a snapshot of the top-level domains and their validity periods, as written by zlint-gtld-update -json. When set, it is used in place of the snapshot built into ZLint"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// TLDMap returns the snapshot at GTLDSnapshot, or the snapshot built into
+// ZLint if GTLDSnapshot is not set.
+func (g GTLDConfig) TLDMap() (*util.TLDMap, error) {
+	if g.GTLDSnapshot == "" {
+		return util.DefaultTLDMap(), nil
+	}
+	m, err := g.files.load("GTLDSnapshot", []string{g.GTLDSnapshot}, func() (interface{}, error) {
+		return util.ReadTLDMap(g.GTLDSnapshot)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.(*util.TLDMap), nil
+}
+
+func (g *GTLDConfig) bind(c Configuration) {
+	g.files = c.files
+}
+
+func (g GTLDConfig) namespace() string {
+	return "GTLDConfig"
+}
+
+// IssuerCertificatesConfig is the higher scoped configuration which services as the deserialization target for...
+//
+// [IssuerCertificatesConfig]
+// ...
+// ...
+//
+// It lists the certificates of the CAs that may have issued the certificates
+// being linted, for the lints that need the issuer's public key.
+type IssuerCertificatesConfig struct {
+	IssuerCertificates string `file:"true" comment:"The path to a PEM file of the certificates that may have issued the certificates being linted. When set, along with a CT log list, the signatures of embedded SCTs are verified"`
+
+	// files holds the files read for the Configuration that this
+	// configuration was deserialized from.
+	files *configurationFiles
+}
+
+// Issuers returns the certificates at IssuerCertificates, or nil if
+// IssuerCertificates is not set.
+func (i IssuerCertificatesConfig) Issuers() ([]*x509.Certificate, error) {
+	if i.IssuerCertificates == "" {
+		return nil, nil
+	}
+	certs, err := i.files.load("IssuerCertificates", []string{i.IssuerCertificates}, func() (interface{}, error) {
+		return util.ReadPEMCertificates(i.IssuerCertificates)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return certs.([]*x509.Certificate), nil
+}
+
+func (i *IssuerCertificatesConfig) bind(c Configuration) {
+	i.files = c.files
+}
+
+func (i IssuerCertificatesConfig) namespace() string {
+	return "IssuerCertificatesConfig"
+}
+
 // CommunityConfig is the higher scoped configuration which services as the deserialization target for...
 //
 // [CommunityConfig]
@@ -129,6 +413,70 @@ func (e EtsiEsiConfig) namespace() string {
 	return "EtsiEsiConfig"
 }
 
+// containsOID reports whether the dotted decimal OIDs include oid.
+func containsOID(oids []string, oid asn1.ObjectIdentifier) bool {
+	for _, o := range oids {
+		if strings.TrimSpace(o) == oid.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// configurationFiles caches the files read for a Configuration by the higher
+// scoped configurations, by kind and paths, so that files such as CT log lists
+// are read once rather than for every input that is linted.
+type configurationFiles struct {
+	files sync.Map
+}
+
+type configurationFileKey struct {
+	kind  string
+	paths string
+}
+
+type configurationFileResult struct {
+	once  sync.Once
+	value interface{}
+	err   error
+}
+
+// load returns the file of the given kind read by read from the given paths.
+// A nil configurationFiles, as held by a configuration that was not
+// deserialized from a Configuration, reads the file every time.
+func (f *configurationFiles) load(kind string, paths []string, read func() (interface{}, error)) (interface{}, error) {
+	if f == nil {
+		return read()
+	}
+	key := configurationFileKey{kind: kind, paths: strings.Join(paths, "\x00")}
+	cached, _ := f.files.LoadOrStore(key, &configurationFileResult{})
+	result := cached.(*configurationFileResult)
+	result.once.Do(func() {
+		result.value, result.err = read()
+	})
+	return result.value, result.err
+}
+
+// loadCTLogList reads the CT log list at path, or returns nil if path is empty.
+func (f *configurationFiles) loadCTLogList(path string) (*util.CTLogList, error) {
+	if path == "" {
+		return nil, nil
+	}
+	list, err := f.load("CTLogList", []string{path}, func() (interface{}, error) {
+		return util.ReadCTLogList(path)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*util.CTLogList), nil
+}
+
+// configurationBinder is implemented by the higher scoped configurations that
+// hold resources shared by the Configuration that they are deserialized from.
+type configurationBinder interface {
+	bind(c Configuration)
+}
+
 // GlobalConfiguration acts both as an interface that can be used to obtain the TOML namespace of configuration
 // as well as a way to mark a fielf in a struct as one of our own, higher scoped, configurations.
 //
@@ -151,5 +499,10 @@ var defaultGlobals = []GlobalConfiguration{
 	&CABFEVGuidelinesConfig{},
 	&MozillaRootStorePolicyConfig{},
 	&AppleRootStorePolicyConfig{},
+	&ChromeRootProgramPolicyConfig{},
+	&KeyBlocklistConfig{},
+	&PublicSuffixListConfig{},
+	&GTLDConfig{},
+	&IssuerCertificatesConfig{},
 	&CommunityConfig{},
 }
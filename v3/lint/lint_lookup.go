@@ -21,10 +21,12 @@ import (
 
 var (
 	// Verify that the interface holds
-	_ linterLookup               = &linterLookupImpl{}
-	_ CertificateLinterLookup    = &certificateLinterLookupImpl{}
-	_ RevocationListLinterLookup = &revocationListLinterLookupImpl{}
-	_ OcspResponseLinterLookup   = &ocspResponseLinterLookupImpl{}
+	_ linterLookup                   = &linterLookupImpl{}
+	_ CertificateLinterLookup        = &certificateLinterLookupImpl{}
+	_ RevocationListLinterLookup     = &revocationListLinterLookupImpl{}
+	_ OcspResponseLinterLookup       = &ocspResponseLinterLookupImpl{}
+	_ RawCertificateLinterLookup     = &rawCertificateLinterLookupImpl{}
+	_ CertificateRequestLinterLookup = &certificateRequestLinterLookupImpl{}
 )
 
 type linterLookup interface {
@@ -289,3 +291,149 @@ func newOcspResponseLintLookup() ocspResponseLinterLookupImpl {
 		lints:            make([]*OcspResponseLint, 0),
 	}
 }
+
+// RawCertificateLinterLookup is an interface describing how registered raw certificate lints can be looked up.
+type RawCertificateLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *RawCertificateLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*RawCertificateLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*RawCertificateLint
+}
+
+type rawCertificateLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*RawCertificateLint
+	lintsBySource map[LintSource][]*RawCertificateLint
+	lints         []*RawCertificateLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *rawCertificateLinterLookupImpl) ByName(name string) *RawCertificateLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *rawCertificateLinterLookupImpl) BySource(s LintSource) []*RawCertificateLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *rawCertificateLinterLookupImpl) Lints() []*RawCertificateLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *rawCertificateLinterLookupImpl) register(lint *RawCertificateLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newRawCertificateLintLookup() rawCertificateLinterLookupImpl {
+	return rawCertificateLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*RawCertificateLint),
+		lintsBySource:    make(map[LintSource][]*RawCertificateLint),
+		lints:            make([]*RawCertificateLint, 0),
+	}
+}
+
+// CertificateRequestLinterLookup is an interface describing how registered certificate signing request lints can be looked up.
+type CertificateRequestLinterLookup interface {
+	linterLookup
+	// ByName returns a pointer to the registered lint with the given name, or nil
+	// if there is no such lint registered in the registry.
+	ByName(name string) *CertificateRequestLint
+	// BySource returns a list of registered lints that have the same LintSource as
+	// provided (or nil if there were no such lints in the registry).
+	BySource(s LintSource) []*CertificateRequestLint
+	// Lints returns a list of all the lints registered.
+	Lints() []*CertificateRequestLint
+}
+
+type certificateRequestLinterLookupImpl struct {
+	linterLookupImpl
+	// lintsByName is a map of all registered lints by name.
+	lintsByName   map[string]*CertificateRequestLint
+	lintsBySource map[LintSource][]*CertificateRequestLint
+	lints         []*CertificateRequestLint
+}
+
+// ByName returns the Lint previously registered under the given name with
+// Register, or nil if no matching lint name has been registered.
+func (lookup *certificateRequestLinterLookupImpl) ByName(name string) *CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsByName[name]
+}
+
+// BySource returns a list of registered lints that have the same LintSource as
+// provided (or nil if there were no such lints).
+func (lookup *certificateRequestLinterLookupImpl) BySource(s LintSource) []*CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lintsBySource[s]
+}
+
+// Lints returns a list of registered lints.
+func (lookup *certificateRequestLinterLookupImpl) Lints() []*CertificateRequestLint {
+	lookup.RLock()
+	defer lookup.RUnlock()
+	return lookup.lints
+}
+
+func (lookup *certificateRequestLinterLookupImpl) register(lint *CertificateRequestLint, name string, source LintSource) error {
+	if name == "" {
+		return errEmptyName
+	}
+	lookup.RLock()
+	defer lookup.RUnlock()
+
+	if existing := lookup.lintsByName[name]; existing != nil {
+		return &errDuplicateName{name}
+	}
+	lookup.lints = append(lookup.lints, lint)
+	lookup.lintNames = append(lookup.lintNames, name)
+	lookup.lintsByName[name] = lint
+
+	lookup.sources[source] = struct{}{}
+	lookup.lintsBySource[source] = append(lookup.lintsBySource[source], lint)
+	sort.Strings(lookup.lintNames)
+	return nil
+}
+
+func newCertificateRequestLintLookup() certificateRequestLinterLookupImpl {
+	return certificateRequestLinterLookupImpl{
+		linterLookupImpl: newLinterLookup(),
+		lintsByName:      make(map[string]*CertificateRequestLint),
+		lintsBySource:    make(map[LintSource][]*CertificateRequestLint),
+		lints:            make([]*CertificateRequestLint, 0),
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// Field is the name of a top level field of a linted structure that
+// a LintResult may point at by way of its Location.
+type Field string
+
+// Known Field values. Their names follow those of the ASN.1 definitions in
+// RFC 5280.
+const (
+	FieldVersion              Field = "version"
+	FieldSerialNumber         Field = "serialNumber"
+	FieldSignature            Field = "signature"
+	FieldIssuer               Field = "issuer"
+	FieldValidity             Field = "validity"
+	FieldSubject              Field = "subject"
+	FieldSubjectPublicKeyInfo Field = "subjectPublicKeyInfo"
+	FieldExtensions           Field = "extensions"
+	FieldSignatureAlgorithm   Field = "signatureAlgorithm"
+	FieldSignatureValue       Field = "signatureValue"
+
+	// Fields specific to revocation lists.
+	FieldThisUpdate          Field = "thisUpdate"
+	FieldNextUpdate          Field = "nextUpdate"
+	FieldRevokedCertificates Field = "revokedCertificates"
+	FieldCRLExtensions       Field = "crlExtensions"
+)
+
+// Location optionally identifies the part of a linted structure that
+// a LintResult concerns, allowing tools to render a finding next to the field
+// that caused it. All members are optional and a Location becomes more
+// specific as more of them are set.
+//
+// OIDs are held in their dotted string form, and serial numbers in hex, so
+// that a Location serializes to JSON in the same way that they are commonly
+// written.
+type Location struct {
+	// Field is the top level field the result concerns.
+	Field Field `json:"field,omitempty"`
+	// Extension is the OID of the extension the result concerns, if any.
+	// Field is always FieldExtensions when Extension is set.
+	Extension string `json:"extension,omitempty"`
+	// Attribute is the OID of the distinguished name attribute the result
+	// concerns, if any. Field is always either FieldSubject or FieldIssuer when
+	// Attribute is set.
+	Attribute string `json:"attribute,omitempty"`
+	// RDNIndex is the zero based index of the RelativeDistinguishedName,
+	// within Field, that holds Attribute.
+	RDNIndex *int `json:"rdn_index,omitempty"`
+	// GeneralNameIndex is the zero based index of the GeneralName, in encoded
+	// order, within the subjectAltName or issuerAltName named by Extension.
+	GeneralNameIndex *int `json:"general_name_index,omitempty"`
+	// GeneralNameType is the RFC 5280 name of the GeneralName choice at
+	// GeneralNameIndex, for example "dNSName".
+	GeneralNameType string `json:"general_name_type,omitempty"`
+	// CRLEntrySerial is the hex encoded serial number of the revoked
+	// certificate entry the result concerns.
+	CRLEntrySerial string `json:"crl_entry_serial,omitempty"`
+	// Path is the ASN.1 path, from the root of the linted structure, of the
+	// element the result concerns, for example
+	// "tbsCertificate.extensions[2.5.29.19].critical".
+	Path string `json:"path,omitempty"`
+	// Offset is the byte offset into the DER encoding of the linted structure
+	// at which the problem was found.
+	Offset *int `json:"offset,omitempty"`
+}
+
+// String returns a compact, human-readable rendering of the Location, for
+// example "extensions[2.5.29.17][1 dNSName]".
+func (l *Location) String() string {
+	if l == nil {
+		return ""
+	}
+	var b strings.Builder
+	if l.Path != "" {
+		// The path identifies the element more precisely than any of the
+		// other members do.
+		b.WriteString(l.Path)
+		if l.Offset != nil {
+			fmt.Fprintf(&b, "@%d", *l.Offset)
+		}
+		return b.String()
+	}
+	b.WriteString(string(l.Field))
+	if l.Extension != "" {
+		fmt.Fprintf(&b, "[%s]", l.Extension)
+	}
+	if l.GeneralNameIndex != nil {
+		fmt.Fprintf(&b, "[%d %s]", *l.GeneralNameIndex, l.GeneralNameType)
+	}
+	if l.RDNIndex != nil {
+		fmt.Fprintf(&b, "[%d]", *l.RDNIndex)
+	}
+	if l.Attribute != "" {
+		fmt.Fprintf(&b, "[%s]", l.Attribute)
+	}
+	if l.CRLEntrySerial != "" {
+		fmt.Fprintf(&b, "[serial %s]", l.CRLEntrySerial)
+	}
+	if l.Offset != nil {
+		fmt.Fprintf(&b, "@%d", *l.Offset)
+	}
+	return b.String()
+}
+
+// AtField returns a Location pointing at the given top level field.
+func AtField(field Field) *Location {
+	return &Location{Field: field}
+}
+
+// AtExtension returns a Location pointing at the extension identified by oid.
+func AtExtension(oid asn1.ObjectIdentifier) *Location {
+	return &Location{Field: FieldExtensions, Extension: oid.String()}
+}
+
+// AtSubjectAttribute returns a Location pointing at the subject distinguished
+// name attribute(s) of the type identified by oid.
+func AtSubjectAttribute(oid asn1.ObjectIdentifier) *Location {
+	return &Location{Field: FieldSubject, Attribute: oid.String()}
+}
+
+// AtIssuerAttribute returns a Location pointing at the issuer distinguished
+// name attribute(s) of the type identified by oid.
+func AtIssuerAttribute(oid asn1.ObjectIdentifier) *Location {
+	return &Location{Field: FieldIssuer, Attribute: oid.String()}
+}
+
+// AtSubjectRDN returns a Location pointing at the attribute of the type
+// identified by oid within the subject RelativeDistinguishedName at index.
+func AtSubjectRDN(index int, oid asn1.ObjectIdentifier) *Location {
+	return &Location{Field: FieldSubject, Attribute: oid.String(), RDNIndex: &index}
+}
+
+// AtIssuerRDN returns a Location pointing at the attribute of the type
+// identified by oid within the issuer RelativeDistinguishedName at index.
+func AtIssuerRDN(index int, oid asn1.ObjectIdentifier) *Location {
+	return &Location{Field: FieldIssuer, Attribute: oid.String(), RDNIndex: &index}
+}
+
+// AtGeneralName returns a Location pointing at the GeneralName with the given
+// tag found at index, in encoded order, within the subjectAltName or
+// issuerAltName extension identified by oid.
+func AtGeneralName(oid asn1.ObjectIdentifier, index int, tag int) *Location {
+	return &Location{
+		Field:            FieldExtensions,
+		Extension:        oid.String(),
+		GeneralNameIndex: &index,
+		GeneralNameType:  util.GeneralNameType(tag),
+	}
+}
+
+// AtSubjectAltName returns a Location pointing at the nth (counting from zero)
+// subjectAltName entry with the given tag. This allows lints iterating over one
+// of the typed slices of c, such as c.DNSNames, to point at the offending
+// GeneralName. If the entry can not be found then the Location points at the
+// extension as a whole.
+func AtSubjectAltName(c *x509.Certificate, tag int, nth int) *Location {
+	return atAltName(c, util.SubjectAlternateNameOID, tag, nth)
+}
+
+// AtIssuerAltName returns a Location pointing at the nth (counting from zero)
+// issuerAltName entry with the given tag. See AtSubjectAltName.
+func AtIssuerAltName(c *x509.Certificate, tag int, nth int) *Location {
+	return atAltName(c, util.IssuerAlternateNameOID, tag, nth)
+}
+
+func atAltName(c *x509.Certificate, oid asn1.ObjectIdentifier, tag int, nth int) *Location {
+	ext := util.GetExtFromCert(c, oid)
+	if ext == nil {
+		return AtExtension(oid)
+	}
+	index := util.GeneralNameIndex(ext.Value, tag, nth)
+	if index < 0 {
+		return AtExtension(oid)
+	}
+	return AtGeneralName(oid, index, tag)
+}
+
+// AtRevokedCertificate returns a Location pointing at the revoked certificate
+// entry of a revocation list with the given serial number.
+func AtRevokedCertificate(serial *big.Int) *Location {
+	return &Location{Field: FieldRevokedCertificates, CRLEntrySerial: fmt.Sprintf("%x", serial)}
+}
+
+// AtOffset returns a Location pointing at the given byte offset into the DER
+// encoding of the linted structure.
+func AtOffset(offset int) *Location {
+	return &Location{Offset: &offset}
+}
+
+// AtASN1Path returns a Location pointing at the element with the given ASN.1
+// path, as reported by util.CheckCertificateDER and
+// util.CheckRevocationListDER, found at the given byte offset into the DER
+// encoding of the linted structure. Field is set when the path is within one of
+// the known top level fields.
+func AtASN1Path(path string, offset int) *Location {
+	location := &Location{Path: path, Offset: &offset}
+	for _, prefix := range []string{"tbsCertificate.", "tbsCertList."} {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		field := strings.TrimPrefix(path, prefix)
+		if end := strings.IndexAny(field, ".["); end >= 0 {
+			field = field[:end]
+		}
+		location.Field = Field(field)
+	}
+	if path == "signatureAlgorithm" || path == "signatureValue" {
+		location.Field = Field(path)
+	}
+	return location
+}
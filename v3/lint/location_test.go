@@ -0,0 +1,140 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func certWithSAN(t *testing.T, names ...asn1.RawValue) *x509.Certificate {
+	value, err := asn1.Marshal(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ext := pkix.Extension{Id: util.SubjectAlternateNameOID, Value: value}
+	return &x509.Certificate{
+		Extensions:    []pkix.Extension{ext},
+		ExtensionsMap: map[string]pkix.Extension{ext.Id.String(): ext},
+	}
+}
+
+func TestAtSubjectAltName(t *testing.T) {
+	c := certWithSAN(t,
+		asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: util.DNSNameTag, Bytes: []byte("a.example.com")},
+		asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: util.IPAddressTag, Bytes: []byte{127, 0, 0, 1}},
+		asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: util.DNSNameTag, Bytes: []byte("b.example.com")},
+	)
+	testCases := []struct {
+		name     string
+		tag      int
+		nth      int
+		expected string
+	}{
+		{"first dNSName", util.DNSNameTag, 0, "extensions[2.5.29.17][0 dNSName]"},
+		{"second dNSName", util.DNSNameTag, 1, "extensions[2.5.29.17][2 dNSName]"},
+		{"iPAddress", util.IPAddressTag, 0, "extensions[2.5.29.17][1 iPAddress]"},
+		{"missing", util.DNSNameTag, 2, "extensions[2.5.29.17]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loc := AtSubjectAltName(c, tc.tag, tc.nth)
+			if loc.String() != tc.expected {
+				t.Errorf("expected location %q, got %q", tc.expected, loc.String())
+			}
+		})
+	}
+	if loc := AtIssuerAltName(c, util.DNSNameTag, 0); loc.String() != "extensions[2.5.29.18]" {
+		t.Errorf("expected issuerAltName location to fall back to the extension, got %q", loc.String())
+	}
+}
+
+func TestLocationJSON(t *testing.T) {
+	testCases := []struct {
+		name         string
+		location     *Location
+		expectedJSON string
+	}{
+		{
+			name:         "field",
+			location:     AtField(FieldSubject),
+			expectedJSON: `{"field":"subject"}`,
+		},
+		{
+			name:         "rdn",
+			location:     AtIssuerRDN(0, util.CountryNameOID),
+			expectedJSON: `{"field":"issuer","attribute":"2.5.4.6","rdn_index":0}`,
+		},
+		{
+			name:         "general name",
+			location:     AtGeneralName(util.SubjectAlternateNameOID, 3, util.DNSNameTag),
+			expectedJSON: `{"field":"extensions","extension":"2.5.29.17","general_name_index":3,"general_name_type":"dNSName"}`,
+		},
+		{
+			name:         "crl entry",
+			location:     AtRevokedCertificate(big.NewInt(255)),
+			expectedJSON: `{"field":"revokedCertificates","crl_entry_serial":"ff"}`,
+		},
+		{
+			name:         "offset",
+			location:     AtOffset(42),
+			expectedJSON: `{"offset":42}`,
+		},
+		{
+			name:         "asn1 path",
+			location:     AtASN1Path("tbsCertificate.extensions[2.5.29.19].critical", 855),
+			expectedJSON: `{"field":"extensions","path":"tbsCertificate.extensions[2.5.29.19].critical","offset":855}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			j, err := json.Marshal(tc.location)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(j) != tc.expectedJSON {
+				t.Errorf("expected Location to marshal to JSON %s, got %s", tc.expectedJSON, j)
+			}
+		})
+	}
+}
+
+func TestAtASN1Path(t *testing.T) {
+	testCases := []struct {
+		path          string
+		expectedField Field
+	}{
+		{"tbsCertificate.subject[0][0]", FieldSubject},
+		{"tbsCertList.revokedCertificates[2].crlEntryExtensions[2.5.29.21]", FieldRevokedCertificates},
+		{"tbsCertList.crlExtensions", FieldCRLExtensions},
+		{"signatureValue", FieldSignatureValue},
+		{"certificate", ""},
+	}
+	for _, tc := range testCases {
+		loc := AtASN1Path(tc.path, 7)
+		if loc.Field != tc.expectedField {
+			t.Errorf("%s: expected field %q, got %q", tc.path, tc.expectedField, loc.Field)
+		}
+		if expected := tc.path + "@7"; loc.String() != expected {
+			t.Errorf("expected location %q, got %q", expected, loc.String())
+		}
+	}
+}
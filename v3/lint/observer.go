@@ -0,0 +1,129 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// InputType identifies the kind of input that a lint is executed against.
+type InputType string
+
+// Known InputType values.
+const (
+	CertificateInput        InputType = "certificate"
+	RevocationListInput     InputType = "revocation_list"
+	OcspResponseInput       InputType = "ocsp_response"
+	RawCertificateInput     InputType = "raw_certificate"
+	CertificateRequestInput InputType = "certificate_request"
+)
+
+// Observer is notified before and after each lint in a Registry is executed
+// against an input, allowing linting to be instrumented with timing, logging or
+// tracing. Observers are given to the functions that execute lints with
+// zlint.WithObserver.
+//
+// Inputs may be linted from several goroutines at once, so implementations
+// must be safe for concurrent use.
+type Observer interface {
+	// BeforeLint is called immediately before the lint described by meta is
+	// executed against an input of the given type.
+	BeforeLint(meta LintMetadata, input InputType)
+	// AfterLint is called immediately after the lint described by meta was
+	// executed against an input of the given type, with the time the lint took
+	// and its result. A lint that panicked has a Fatal result.
+	AfterLint(meta LintMetadata, input InputType, duration time.Duration, result *LintResult)
+}
+
+// LintTiming is the time spent executing a single lint, as recorded by
+// a TimingObserver.
+type LintTiming struct {
+	Name  string        `json:"name"`
+	Runs  int           `json:"runs"`
+	Total time.Duration `json:"total"`
+	Max   time.Duration `json:"max"`
+}
+
+// Mean returns the mean time taken by each run of the lint.
+func (t LintTiming) Mean() time.Duration {
+	if t.Runs == 0 {
+		return 0
+	}
+	return t.Total / time.Duration(t.Runs)
+}
+
+// TimingObserver is an Observer that records how long each lint takes across
+// every input it is executed against.
+type TimingObserver struct {
+	mu      sync.Mutex
+	timings map[string]*LintTiming
+}
+
+// NewTimingObserver returns a TimingObserver that has not recorded anything.
+func NewTimingObserver() *TimingObserver {
+	return &TimingObserver{timings: map[string]*LintTiming{}}
+}
+
+// BeforeLint implements Observer.
+func (o *TimingObserver) BeforeLint(meta LintMetadata, input InputType) {}
+
+// AfterLint implements Observer.
+func (o *TimingObserver) AfterLint(meta LintMetadata, input InputType, duration time.Duration, result *LintResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	timing, ok := o.timings[meta.Name]
+	if !ok {
+		timing = &LintTiming{Name: meta.Name}
+		o.timings[meta.Name] = timing
+	}
+	timing.Runs++
+	timing.Total += duration
+	if duration > timing.Max {
+		timing.Max = duration
+	}
+}
+
+// Timings returns the time recorded for each lint, slowest in total first.
+func (o *TimingObserver) Timings() []LintTiming {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	timings := make([]LintTiming, 0, len(o.timings))
+	for _, timing := range o.timings {
+		timings = append(timings, *timing)
+	}
+	sort.Slice(timings, func(i, j int) bool {
+		if timings[i].Total != timings[j].Total {
+			return timings[i].Total > timings[j].Total
+		}
+		return timings[i].Name < timings[j].Name
+	})
+	return timings
+}
+
+// WriteProfile writes a table of the time recorded for each lint, slowest in
+// total first, to w.
+func (o *TimingObserver) WriteProfile(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Lint\tRuns\tTotal\tMean\tMax")
+	for _, timing := range o.Timings() {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", timing.Name, timing.Runs, timing.Total, timing.Mean(), timing.Max)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,53 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimingObserver(t *testing.T) {
+	o := NewTimingObserver()
+	fast := LintMetadata{Name: "e_fast"}
+	slow := LintMetadata{Name: "e_slow"}
+	o.AfterLint(fast, CertificateInput, time.Millisecond, &LintResult{Status: Pass})
+	o.AfterLint(slow, CertificateInput, 3*time.Millisecond, &LintResult{Status: Pass})
+	o.AfterLint(fast, CertificateInput, 3*time.Millisecond, &LintResult{Status: Error})
+	o.AfterLint(slow, CertificateInput, 5*time.Millisecond, &LintResult{Status: Pass})
+
+	timings := o.Timings()
+	if len(timings) != 2 {
+		t.Fatalf("expected 2 timings, got %d", len(timings))
+	}
+	expected := LintTiming{Name: "e_slow", Runs: 2, Total: 8 * time.Millisecond, Max: 5 * time.Millisecond}
+	if timings[0] != expected {
+		t.Errorf("expected the slowest lint first as %+v, got %+v", expected, timings[0])
+	}
+	if timings[1].Mean() != 2*time.Millisecond {
+		t.Errorf("expected a mean of 2ms, got %s", timings[1].Mean())
+	}
+
+	var out bytes.Buffer
+	if err := o.WriteProfile(&out); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "e_slow") {
+		t.Errorf("unexpected profile:\n%s", out.String())
+	}
+}
@@ -116,13 +116,53 @@ type Registry interface { //nolint: interfacebloat // Somewhat unavoidable here.
 	OcspResponseLints() OcspResponseLinterLookup
 }
 
+// RawCertificateRegistry is implemented by the Registries that hold
+// RawCertificateLints, as every Registry returned by this package does. It is
+// kept apart from Registry so that implementations of Registry outside of this
+// package need not change, and hold no RawCertificateLints.
+type RawCertificateRegistry interface {
+	// RawCertificateLints returns an interface used to lookup
+	// RawCertificateLints.
+	RawCertificateLints() RawCertificateLinterLookup
+}
+
+// CertificateRequestRegistry is implemented by the Registries that hold
+// CertificateRequestLints, as every Registry returned by this package does. It
+// is kept apart from Registry so that implementations of Registry outside of
+// this package need not change, and hold no CertificateRequestLints.
+type CertificateRequestRegistry interface {
+	// CertificateRequestLints returns an interface used to lookup
+	// CertificateRequestLints.
+	CertificateRequestLints() CertificateRequestLinterLookup
+}
+
+// RawCertificateLintsOf returns the RawCertificateLints of registry, or nil if
+// it does not implement RawCertificateRegistry.
+func RawCertificateLintsOf(registry Registry) []*RawCertificateLint {
+	if r, ok := registry.(RawCertificateRegistry); ok {
+		return r.RawCertificateLints().Lints()
+	}
+	return nil
+}
+
+// CertificateRequestLintsOf returns the CertificateRequestLints of registry, or
+// nil if it does not implement CertificateRequestRegistry.
+func CertificateRequestLintsOf(registry Registry) []*CertificateRequestLint {
+	if r, ok := registry.(CertificateRequestRegistry); ok {
+		return r.CertificateRequestLints().Lints()
+	}
+	return nil
+}
+
 // registryImpl implements the Registry interface to provide a global collection
 // of Lints that have been registered.
 type registryImpl struct {
-	certificateLints    certificateLinterLookupImpl
-	ocspResponseLints   ocspResponseLinterLookupImpl
-	revocationListLints revocationListLinterLookupImpl
-	configuration       Configuration
+	certificateLints        certificateLinterLookupImpl
+	ocspResponseLints       ocspResponseLinterLookupImpl
+	revocationListLints     revocationListLinterLookupImpl
+	rawCertificateLints     rawCertificateLinterLookupImpl
+	certificateRequestLints certificateRequestLinterLookupImpl
+	configuration           Configuration
 }
 
 var (
@@ -207,6 +247,35 @@ func (r *registryImpl) registerOcspResponseLint(l *OcspResponseLint) error {
 	return r.ocspResponseLints.register(l, l.Name, l.Source)
 }
 
+// registerRawCertificateLint registers a RawCertificateLint to the registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name or if the Name was previously registered.
+func (r *registryImpl) registerRawCertificateLint(l *RawCertificateLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	return r.rawCertificateLints.register(l, l.Name, l.Source)
+}
+
+// registerCertificateRequestLint registers a CertificateRequestLint to the
+// registry.
+//
+// An error is returned if the lint or lint's Lint pointer is nil, if the Lint
+// has an empty Name or if the Name was previously registered.
+func (r *registryImpl) registerCertificateRequestLint(l *CertificateRequestLint) error {
+	if l == nil {
+		return errNilLint
+	}
+	if l.Lint() == nil {
+		return errNilLintPtr
+	}
+	return r.certificateRequestLints.register(l, l.Name, l.Source)
+}
+
 // ByName returns the Lint previously registered under the given name with
 // Register, or nil if no matching lint name has been registered.
 //
@@ -227,6 +296,8 @@ func (r *registryImpl) Names() []string {
 	names = append(names, r.certificateLints.lintNames...)
 	names = append(names, r.ocspResponseLints.lintNames...)
 	names = append(names, r.revocationListLints.lintNames...)
+	names = append(names, r.rawCertificateLints.lintNames...)
+	names = append(names, r.certificateRequestLints.lintNames...)
 
 	sort.Strings(names)
 	return names
@@ -263,6 +334,12 @@ func (r *registryImpl) Sources() SourceList {
 	for _, source := range r.ocspResponseLints.Sources() {
 		set[source] = struct{}{}
 	}
+	for _, source := range r.rawCertificateLints.Sources() {
+		set[source] = struct{}{}
+	}
+	for _, source := range r.certificateRequestLints.Sources() {
+		set[source] = struct{}{}
+	}
 	var sources SourceList
 	for source := range set {
 		sources = append(sources, source)
@@ -282,6 +359,14 @@ func (r *registryImpl) OcspResponseLints() OcspResponseLinterLookup {
 	return &r.ocspResponseLints
 }
 
+func (r *registryImpl) RawCertificateLints() RawCertificateLinterLookup {
+	return &r.rawCertificateLints
+}
+
+func (r *registryImpl) CertificateRequestLints() CertificateRequestLinterLookup {
+	return &r.certificateRequestLints
+}
+
 // lintNamesToMap converts a list of lit names into a bool hashmap useful for
 // filtering. If any of the lint names are not known by the registry an error is
 // returned.
@@ -306,6 +391,14 @@ func (r *registryImpl) lintNamesToMap(names []string) (map[string]bool, error) {
 			namesMap[n] = true
 			continue
 		}
+		if l := r.rawCertificateLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
+		if l := r.certificateRequestLints.ByName(n); l != nil {
+			namesMap[n] = true
+			continue
+		}
 		return nil, fmt.Errorf("unknown lint name %q", n)
 	}
 	return namesMap, nil
@@ -379,6 +472,16 @@ func (r *registryImpl) Filter(opts FilterOptions) (Registry, error) {
 			registerFunc = func() error {
 				return filteredRegistry.registerRevocationListLint(l)
 			}
+		} else if l := r.rawCertificateLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			registerFunc = func() error {
+				return filteredRegistry.registerRawCertificateLint(l)
+			}
+		} else if l := r.certificateRequestLints.ByName(name); l != nil {
+			meta = l.LintMetadata
+			registerFunc = func() error {
+				return filteredRegistry.registerCertificateRequestLint(l)
+			}
 		}
 
 		if sourceExcludes != nil && sourceExcludes[meta.Source] {
@@ -423,10 +526,37 @@ func (r *registryImpl) WriteJSON(w io.Writer) {
 		//nolint:errchkjson
 		_ = enc.Encode(lint)
 	}
+	for _, lint := range r.rawCertificateLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
+	for _, lint := range r.certificateRequestLints.Lints() {
+		//nolint:errchkjson
+		_ = enc.Encode(lint)
+	}
 }
 
+// SetConfiguration sets the Configuration used to configure the lints of the
+// registry, and configures each lint registered so far. Configured lint
+// instances are cached within cfg, and so are shared with any registry that
+// is created from this one using Filter.
 func (r *registryImpl) SetConfiguration(cfg Configuration) {
 	r.configuration = cfg
+	for _, l := range r.certificateLints.Lints() {
+		_, _ = cfg.configuredCertificateLint(l)
+	}
+	for _, l := range r.revocationListLints.Lints() {
+		_, _ = cfg.configuredRevocationListLint(l)
+	}
+	for _, l := range r.ocspResponseLints.Lints() {
+		_, _ = cfg.configuredOcspResponseLint(l)
+	}
+	for _, l := range r.rawCertificateLints.Lints() {
+		_, _ = cfg.configuredRawCertificateLint(l)
+	}
+	for _, l := range r.certificateRequestLints.Lints() {
+		_, _ = cfg.configuredCertificateRequestLint(l)
+	}
 }
 
 func (r *registryImpl) GetConfiguration() Configuration {
@@ -449,7 +579,7 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 	for name, lint := range r.certificateLints.lintsByName {
 		switch configurable := lint.Lint().(type) {
 		case Configurable:
-			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+			addLintExample(configurables, name, configurable)
 		default:
 		}
 	}
@@ -457,7 +587,7 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 	for name, lint := range r.ocspResponseLints.lintsByName {
 		switch configurable := lint.Lint().(type) {
 		case Configurable:
-			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+			addLintExample(configurables, name, configurable)
 		default:
 
 		}
@@ -466,7 +596,23 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 	for name, lint := range r.revocationListLints.lintsByName {
 		switch configurable := lint.Lint().(type) {
 		case Configurable:
-			configurables[name] = stripGlobalsFromExample(configurable.Configure())
+			addLintExample(configurables, name, configurable)
+		default:
+		}
+	}
+
+	for name, lint := range r.rawCertificateLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			addLintExample(configurables, name, configurable)
+		default:
+		}
+	}
+
+	for name, lint := range r.certificateRequestLints.lintsByName {
+		switch configurable := lint.Lint().(type) {
+		case Configurable:
+			addLintExample(configurables, name, configurable)
 		default:
 		}
 	}
@@ -500,15 +646,28 @@ func (r *registryImpl) defaultConfiguration(globals []GlobalConfiguration) ([]by
 	return w.Bytes(), nil
 }
 
+// addLintExample adds the example configuration of the named lint to
+// configurables, unless the lint is configured only by higher scoped
+// configurations, which are printed in sections of their own.
+func addLintExample(configurables map[string]interface{}, name string, configurable Configurable) {
+	example := stripGlobalsFromExample(configurable.Configure())
+	if m, ok := example.(map[string]interface{}); ok && len(m) == 0 {
+		return
+	}
+	configurables[name] = example
+}
+
 // NewRegistry constructs a Registry implementation that can be used to register
 // lints.
 //
 //nolint:revive
 func NewRegistry() *registryImpl {
 	registry := &registryImpl{
-		certificateLints:    newCertificateLintLookup(),
-		ocspResponseLints:   newOcspResponseLintLookup(),
-		revocationListLints: newRevocationListLintLookup(),
+		certificateLints:        newCertificateLintLookup(),
+		ocspResponseLints:       newOcspResponseLintLookup(),
+		revocationListLints:     newRevocationListLintLookup(),
+		rawCertificateLints:     newRawCertificateLintLookup(),
+		certificateRequestLints: newCertificateRequestLintLookup(),
 	}
 	registry.SetConfiguration(NewEmptyConfig())
 	return registry
@@ -576,6 +735,35 @@ func RegisterRevocationListLint(l *RevocationListLint) {
 	}
 }
 
+// RegisterRawCertificateLint must be called once for each RawCertificateLint to
+// be executed. Normally, RegisterRawCertificateLint is called from the Go init()
+// function of a lint implementation.
+//
+// IMPORTANT: RegisterRawCertificateLint will panic if given a nil lint, or a
+// lint with a nil Lint pointer, or if the lint name matches a previously
+// registered lint's name. These conditions all indicate a bug that should be
+// addressed by a developer.
+func RegisterRawCertificateLint(l *RawCertificateLint) {
+	if err := globalRegistry.registerRawCertificateLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
+// RegisterCertificateRequestLint must be called once for each
+// CertificateRequestLint to be executed. Normally,
+// RegisterCertificateRequestLint is called from the Go init() function of
+// a lint implementation.
+//
+// IMPORTANT: RegisterCertificateRequestLint will panic if given a nil lint, or
+// a lint with a nil Lint pointer, or if the lint name matches a previously
+// registered lint's name. These conditions all indicate a bug that should be
+// addressed by a developer.
+func RegisterCertificateRequestLint(l *CertificateRequestLint) {
+	if err := globalRegistry.registerCertificateRequestLint(l); err != nil {
+		panic(fmt.Sprintf("RegisterLint error: %v\n", err.Error()))
+	}
+}
+
 // GlobalRegistry is the Registry used by RegisterLint and contains all of the
 // lints that are loaded.
 //
@@ -0,0 +1,197 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"fmt"
+)
+
+// RegistryBuilder assembles a Registry from a hand-picked set of the globally
+// registered lints and any number of lints that have not been registered
+// globally, such as lints private to an application embedding ZLint.
+//
+// Registries built by a RegistryBuilder are independent of the global
+// registry and can not have lints added to them.
+//
+// The methods of a RegistryBuilder record the first error that occurs, which
+// is then returned by Build, so that calls may be chained. For example...
+//
+// ```
+//
+//	registry, err := lint.NewRegistryBuilder().
+//		ImportSources(lint.RFC5280).
+//		ImportNames("e_sub_cert_aia_missing").
+//		AddCertificateLint(myLint).
+//		Build()
+//
+// ```
+type RegistryBuilder struct {
+	registry *registryImpl
+	err      error
+}
+
+// NewRegistryBuilder returns a RegistryBuilder for an empty Registry with an
+// empty configuration.
+func NewRegistryBuilder() *RegistryBuilder {
+	return &RegistryBuilder{registry: NewRegistry()}
+}
+
+// ImportNames adds the globally registered lints with the given names. It is an
+// error for any of the names to not be globally registered.
+func (b *RegistryBuilder) ImportNames(names ...string) *RegistryBuilder {
+	for _, name := range names {
+		if !b.importName(name) {
+			b.fail(fmt.Errorf("unknown lint name %q", name))
+		}
+	}
+	return b
+}
+
+// ImportSources adds every globally registered lint with one of the given
+// sources.
+func (b *RegistryBuilder) ImportSources(sources ...LintSource) *RegistryBuilder {
+	for _, source := range sources {
+		for _, l := range globalRegistry.certificateLints.BySource(source) {
+			b.importName(l.Name)
+		}
+		for _, l := range globalRegistry.revocationListLints.BySource(source) {
+			b.importName(l.Name)
+		}
+		for _, l := range globalRegistry.ocspResponseLints.BySource(source) {
+			b.importName(l.Name)
+		}
+		for _, l := range globalRegistry.rawCertificateLints.BySource(source) {
+			b.importName(l.Name)
+		}
+		for _, l := range globalRegistry.certificateRequestLints.BySource(source) {
+			b.importName(l.Name)
+		}
+	}
+	return b
+}
+
+// ImportProfile adds the globally registered lints named by the profile with
+// the given name. It is an error for there to be no such profile.
+func (b *RegistryBuilder) ImportProfile(name string) *RegistryBuilder {
+	profile, ok := GetProfile(name)
+	if !ok {
+		b.fail(fmt.Errorf("unknown profile name %q", name))
+		return b
+	}
+	return b.ImportNames(profile.LintNames...)
+}
+
+// AddCertificateLint adds a certificate lint that need not have been globally
+// registered. It is an error for the registry to already hold a lint with the
+// same name.
+func (b *RegistryBuilder) AddCertificateLint(l *CertificateLint) *RegistryBuilder {
+	b.fail(b.registry.registerCertificateLint(l))
+	return b
+}
+
+// AddRevocationListLint adds a revocation list lint that need not have been
+// globally registered. It is an error for the registry to already hold a lint
+// with the same name.
+func (b *RegistryBuilder) AddRevocationListLint(l *RevocationListLint) *RegistryBuilder {
+	b.fail(b.registry.registerRevocationListLint(l))
+	return b
+}
+
+// AddOcspResponseLint adds an OCSP response lint that need not have been
+// globally registered. It is an error for the registry to already hold a lint
+// with the same name.
+func (b *RegistryBuilder) AddOcspResponseLint(l *OcspResponseLint) *RegistryBuilder {
+	b.fail(b.registry.registerOcspResponseLint(l))
+	return b
+}
+
+// AddRawCertificateLint adds a raw certificate lint that need not have been
+// globally registered. It is an error for the registry to already hold a lint
+// with the same name.
+func (b *RegistryBuilder) AddRawCertificateLint(l *RawCertificateLint) *RegistryBuilder {
+	b.fail(b.registry.registerRawCertificateLint(l))
+	return b
+}
+
+// AddCertificateRequestLint adds a certificate signing request lint that need
+// not have been globally registered. It is an error for the registry to
+// already hold a lint with the same name.
+func (b *RegistryBuilder) AddCertificateRequestLint(l *CertificateRequestLint) *RegistryBuilder {
+	b.fail(b.registry.registerCertificateRequestLint(l))
+	return b
+}
+
+// WithConfiguration sets the Configuration of the registry. If it is not
+// called then the registry has an empty configuration.
+func (b *RegistryBuilder) WithConfiguration(config Configuration) *RegistryBuilder {
+	b.registry.SetConfiguration(config)
+	return b
+}
+
+// Build returns the assembled Registry, or the first error encountered while
+// assembling it. The RegistryBuilder must not be used after calling Build.
+func (b *RegistryBuilder) Build() (Registry, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	// Configure any lints added since the configuration was set.
+	b.registry.SetConfiguration(b.registry.configuration)
+	registry := b.registry
+	b.registry = nil
+	return registry, nil
+}
+
+// importName adds the globally registered lint with the given name, unless it
+// has already been added, and reports whether there is such a lint.
+func (b *RegistryBuilder) importName(name string) bool {
+	if l := globalRegistry.certificateLints.ByName(name); l != nil {
+		if b.registry.certificateLints.ByName(name) != l {
+			b.fail(b.registry.registerCertificateLint(l))
+		}
+		return true
+	}
+	if l := globalRegistry.revocationListLints.ByName(name); l != nil {
+		if b.registry.revocationListLints.ByName(name) != l {
+			b.fail(b.registry.registerRevocationListLint(l))
+		}
+		return true
+	}
+	if l := globalRegistry.ocspResponseLints.ByName(name); l != nil {
+		if b.registry.ocspResponseLints.ByName(name) != l {
+			b.fail(b.registry.registerOcspResponseLint(l))
+		}
+		return true
+	}
+	if l := globalRegistry.rawCertificateLints.ByName(name); l != nil {
+		if b.registry.rawCertificateLints.ByName(name) != l {
+			b.fail(b.registry.registerRawCertificateLint(l))
+		}
+		return true
+	}
+	if l := globalRegistry.certificateRequestLints.ByName(name); l != nil {
+		if b.registry.certificateRequestLints.ByName(name) != l {
+			b.fail(b.registry.registerCertificateRequestLint(l))
+		}
+		return true
+	}
+	return false
+}
+
+// fail records err if it is the first error encountered.
+func (b *RegistryBuilder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package lint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mockCertificateLint(name string, source LintSource) *CertificateLint {
+	return &CertificateLint{
+		LintMetadata: LintMetadata{Name: name, Source: source},
+		Lint:         func() CertificateLintInterface { return &mockLint{} },
+	}
+}
+
+// withGlobalRegistry replaces the global registry with one holding only the
+// given lints for the duration of the test.
+func withGlobalRegistry(t *testing.T, lints ...*CertificateLint) {
+	original := globalRegistry
+	t.Cleanup(func() { globalRegistry = original })
+	globalRegistry = NewRegistry()
+	for _, l := range lints {
+		if err := globalRegistry.registerCertificateLint(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRegistryBuilder(t *testing.T) {
+	withGlobalRegistry(t,
+		mockCertificateLint("e_rfc_one", RFC5280),
+		mockCertificateLint("e_rfc_two", RFC5280),
+		mockCertificateLint("e_br_one", CABFBaselineRequirements),
+		mockCertificateLint("w_community_one", Community),
+	)
+	RegisterProfile(Profile{Name: "test_profile", LintNames: []string{"e_br_one", "e_rfc_one"}})
+	t.Cleanup(func() { delete(profiles, "test_profile") })
+
+	custom := mockCertificateLint("e_custom", Community)
+	registry, err := NewRegistryBuilder().
+		ImportSources(RFC5280).
+		ImportProfile("test_profile").
+		AddCertificateLint(custom).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"e_br_one", "e_custom", "e_rfc_one", "e_rfc_two"}
+	if names := registry.Names(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected lints %v, got %v", expected, names)
+	}
+	if registry.CertificateLints().ByName("e_custom") != custom {
+		t.Errorf("expected the custom lint to be registered")
+	}
+	if globalRegistry.CertificateLints().ByName("e_custom") != nil {
+		t.Errorf("expected the custom lint to not be registered globally")
+	}
+
+	filtered, err := registry.Filter(FilterOptions{IncludeSources: SourceList{RFC5280}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"e_rfc_one", "e_rfc_two"}
+	if names := filtered.Names(); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected filtered lints %v, got %v", expected, names)
+	}
+}
+
+func TestRegistryBuilderErrors(t *testing.T) {
+	withGlobalRegistry(t, mockCertificateLint("e_rfc_one", RFC5280))
+
+	testCases := []struct {
+		name    string
+		builder *RegistryBuilder
+	}{
+		{
+			name:    "unknown lint name",
+			builder: NewRegistryBuilder().ImportNames("e_rfc_one", "e_not_a_lint"),
+		},
+		{
+			name:    "unknown profile",
+			builder: NewRegistryBuilder().ImportProfile("not_a_profile"),
+		},
+		{
+			name: "duplicate name",
+			builder: NewRegistryBuilder().
+				ImportNames("e_rfc_one").
+				AddCertificateLint(mockCertificateLint("e_rfc_one", Community)),
+		},
+		{
+			name:    "nil lint",
+			builder: NewRegistryBuilder().AddRevocationListLint(nil),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if registry, err := tc.builder.Build(); err == nil {
+				t.Errorf("expected an error, got registry with lints %v", registry.Names())
+			}
+		})
+	}
+}
+
+func TestRegistryBuilderConfiguration(t *testing.T) {
+	config, err := NewConfigFromString("[Global]\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := NewRegistryBuilder().WithConfiguration(config).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registry.GetConfiguration().tree != config.tree {
+		t.Errorf("expected the registry to have the configuration it was built with")
+	}
+	registry.SetConfiguration(NewEmptyConfig())
+	if registry.GetConfiguration().tree == config.tree {
+		t.Errorf("expected SetConfiguration to replace the configuration of a built registry")
+	}
+}
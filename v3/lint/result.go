@@ -59,14 +59,50 @@ var (
 	}
 )
 
-// LintResult contains a LintStatus, and an optional human-readable description.
+// LintResult contains a LintStatus, an optional human-readable description
+// and an optional Location of the field the result concerns.
 // The output of a lint is a LintResult.
+//
+// Lints that are able to report more than one problem in a single run, such as
+// one per offending subjectAltName, also list each of them in Findings. In that
+// case Status is the worst status of any finding, and Details and Location are
+// those of the first finding with that status, so that consumers only
+// interested in a single result per lint are unaffected.
 type LintResult struct {
 	Status       LintStatus   `json:"result"`
 	Details      string       `json:"details,omitempty"`
+	Location     *Location    `json:"location,omitempty"`
+	Findings     []Finding    `json:"findings,omitempty"`
 	LintMetadata LintMetadata `json:"-"`
 }
 
+// Finding is a single problem reported by a lint.
+type Finding struct {
+	Status   LintStatus `json:"result"`
+	Details  string     `json:"details,omitempty"`
+	Location *Location  `json:"location,omitempty"`
+}
+
+// ResultFromFindings returns the LintResult summarizing the given findings.
+// If there are no findings then the result is Pass.
+func ResultFromFindings(findings []Finding) *LintResult {
+	if len(findings) == 0 {
+		return &LintResult{Status: Pass}
+	}
+	worst := findings[0]
+	for _, finding := range findings[1:] {
+		if finding.Status > worst.Status {
+			worst = finding
+		}
+	}
+	return &LintResult{
+		Status:   worst.Status,
+		Details:  worst.Details,
+		Location: worst.Location,
+		Findings: findings,
+	}
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (e LintStatus) MarshalJSON() ([]byte, error) {
 	s := e.String()
@@ -66,3 +66,26 @@ func TestMarshalingLintStatus(t *testing.T) {
 	}
 
 }
+
+func TestResultFromFindings(t *testing.T) {
+	if result := ResultFromFindings(nil); result.Status != Pass || result.Findings != nil {
+		t.Errorf("expected no findings to result in a pass, got %+v", result)
+	}
+
+	findings := []Finding{
+		{Status: Warn, Details: "first warning", Location: AtField(FieldSubject)},
+		{Status: Error, Details: "first error", Location: AtField(FieldIssuer)},
+		{Status: Notice, Details: "notice"},
+		{Status: Error, Details: "second error"},
+	}
+	result := ResultFromFindings(findings)
+	if result.Status != Error {
+		t.Errorf("expected the worst status %s, got %s", Error, result.Status)
+	}
+	if result.Details != "first error" || result.Location.String() != "issuer" {
+		t.Errorf("expected the details and location of the first error, got %q at %q", result.Details, result.Location)
+	}
+	if len(result.Findings) != len(findings) {
+		t.Errorf("expected %d findings, got %d", len(findings), len(result.Findings))
+	}
+}
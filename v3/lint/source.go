@@ -41,6 +41,7 @@ const (
 	CABFEVGuidelines              LintSource = "CABF_EV"
 	MozillaRootStorePolicy        LintSource = "Mozilla"
 	AppleRootStorePolicy          LintSource = "Apple"
+	ChromeRootProgramPolicy       LintSource = "Chrome"
 	Community                     LintSource = "Community"
 	EtsiEsi                       LintSource = "ETSI_ESI"
 )
@@ -67,6 +68,7 @@ func (s *LintSource) UnmarshalJSON(data []byte) error {
 		CABFEVGuidelines,
 		MozillaRootStorePolicy,
 		AppleRootStorePolicy,
+		ChromeRootProgramPolicy,
 		Community,
 		EtsiEsi:
 		*s = LintSource(throwAway)
@@ -110,6 +112,8 @@ func (s *LintSource) FromString(src string) {
 		*s = MozillaRootStorePolicy
 	case AppleRootStorePolicy:
 		*s = AppleRootStorePolicy
+	case ChromeRootProgramPolicy:
+		*s = ChromeRootProgramPolicy
 	case Community:
 		*s = Community
 	case EtsiEsi:
@@ -0,0 +1,80 @@
+package apple
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestCTLogListSCTCount(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Filename       string
+		LogList        string
+		ExpectedResult lint.LintStatus
+	}{
+		{
+			Name:           "No log list",
+			Filename:       "ct3mo2SCTs.pem",
+			ExpectedResult: lint.NA,
+		},
+		{
+			Name:           "No SCTs, poisoned",
+			Filename:       "ctNoSCTsPoisoned.pem",
+			LogList:        "ctLogList.json",
+			ExpectedResult: lint.NA,
+		},
+		{
+			Name:           "No SCTs, no poison",
+			Filename:       "ctNoSCTs.pem",
+			LogList:        "ctLogList.json",
+			ExpectedResult: lint.Notice,
+		},
+		{
+			Name:           "Lifetime <180d, 2 SCTs from usable logs",
+			Filename:       "ct3mo2SCTs.pem",
+			LogList:        "ctLogList.json",
+			ExpectedResult: lint.Pass,
+		},
+		{
+			Name:           "Lifetime >180d, 2 SCTs",
+			Filename:       "ct18mo2SCTs.pem",
+			LogList:        "ctLogList.json",
+			ExpectedResult: lint.Notice,
+		},
+		{
+			Name:           "Missing log list",
+			Filename:       "ct3mo2SCTs.pem",
+			LogList:        "ctLogListMissing.json",
+			ExpectedResult: lint.Fatal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			config := ""
+			if tc.LogList != "" {
+				config = "[AppleRootStorePolicyConfig]\nCTLogList = \"../../testdata/" + tc.LogList + "\""
+			}
+			result := test.TestLintWithConfig("n_apple_ct_sct_count_unsatisfied", tc.Filename, config)
+			if result.Status != tc.ExpectedResult {
+				t.Errorf("expected result %v was %v: %s", tc.ExpectedResult, result.Status, result.Details)
+			}
+		})
+	}
+}
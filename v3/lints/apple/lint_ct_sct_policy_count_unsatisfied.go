@@ -77,7 +77,9 @@ func (l *sctPolicyCount) CheckApplies(c *x509.Certificate) bool {
 // Important note 2: The linter doesn't maintain a list of Apple's trusted
 // logs. The SCTs embedded in the certificate may not be from log's Apple
 // actually trusts. Similarly the embedded SCT signatures are not validated
-// in any way.
+// in any way. When a copy of Apple's CT log list is configured the
+// n_apple_ct_sct_count_unsatisfied and n_apple_ct_sct_operators_unsatisfied
+// lints check the embedded SCTs against it.
 //
 // [0]: https://support.apple.com/en-us/HT205280
 // [1]: https://github.com/zmap/zlint/issues/226
@@ -51,7 +51,11 @@ func (l *crlReasonCodeNotCritical) Execute(c *x509.RevocationList) *lint.LintRes
 		for _, ext := range c.Extensions {
 			if ext.Id.Equal(util.ReasonCodeOID) {
 				if ext.Critical {
-					return &lint.LintResult{Status: lint.Error, Details: "CRL Reason Code extension MUST NOT be marked as critical."}
+					return &lint.LintResult{
+						Status:   lint.Error,
+						Details:  "CRL Reason Code extension MUST NOT be marked as critical.",
+						Location: lint.AtRevokedCertificate(c.SerialNumber),
+					}
 				}
 			}
 		}
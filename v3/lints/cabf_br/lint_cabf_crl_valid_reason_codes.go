@@ -58,10 +58,18 @@ func (l *crlHasValidReasonCodes) Execute(c *x509.RevocationList) *lint.LintResul
 		}
 		code := *c.ReasonCode
 		if code == 0 {
-			return &lint.LintResult{Status: lint.Error, Details: "The reason code CRL entry extension SHOULD be absent instead of using the unspecified (0) reasonCode value."}
+			return &lint.LintResult{
+				Status:   lint.Error,
+				Details:  "The reason code CRL entry extension SHOULD be absent instead of using the unspecified (0) reasonCode value.",
+				Location: lint.AtRevokedCertificate(c.SerialNumber),
+			}
 		}
 		if _, ok := validReasons[code]; !ok {
-			return &lint.LintResult{Status: lint.Error, Details: "Reason code not included in BR: 7.2.2"}
+			return &lint.LintResult{
+				Status:   lint.Error,
+				Details:  "Reason code not included in BR: 7.2.2",
+				Location: lint.AtRevokedCertificate(c.SerialNumber),
+			}
 		}
 	}
 	return &lint.LintResult{Status: lint.Pass}
@@ -0,0 +1,89 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if...
+3. The CA has previously been made aware that the Applicant's Private Key has
+   suffered a Key Compromise, such as through the provisions of Section
+   4.9.1.1, unless the CA has been made aware that...
+
+This lint is only applied when compromised key blocklists are configured by
+the CompromisedKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type compromisedKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_compromised_key",
+			Description:   "The CA must reject certificate requests for keys known to be compromised",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewCompromisedKey,
+	})
+}
+
+func NewCompromisedKey() lint.LintInterface {
+	return &compromisedKey{}
+}
+
+func (l *compromisedKey) Configure() interface{} {
+	return l
+}
+
+func (l *compromisedKey) CheckApplies(c *x509.Certificate) bool {
+	return len(l.KeyBlocklistConfig.CompromisedKeys) > 0
+}
+
+func (l *compromisedKey) Execute(c *x509.Certificate) *lint.LintResult {
+	return checkCompromisedKey(l.KeyBlocklistConfig, c.RawSubjectPublicKeyInfo, lint.AtField(lint.FieldSubjectPublicKeyInfo))
+}
+
+// checkCompromisedKey checks the DER encoded SubjectPublicKeyInfo of a
+// certificate or certificate signing request against the configured
+// compromised key blocklists.
+func checkCompromisedKey(config lint.KeyBlocklistConfig, spki []byte, location *lint.Location) *lint.LintResult {
+	blocklist, err := config.CompromisedKeyBlocklist()
+	if err != nil {
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("Failed to read the compromised key blocklists: %s", err),
+		}
+	}
+	if blocklist.Contains(spki) {
+		return &lint.LintResult{
+			Status:   lint.Error,
+			Details:  fmt.Sprintf("The key with SPKI SHA-256 hash %x is known to be compromised", sha256.Sum256(spki)),
+			Location: location,
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,65 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if... The CA has previously been made
+aware that the Applicant's Private Key has suffered a Key Compromise...
+
+This is checked against the key of the certificate signing request itself, so
+that a request can be rejected before a certificate is issued. This lint is
+only applied when compromised key blocklists are configured by the
+CompromisedKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type csrCompromisedKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_compromised_key",
+			Description:   "The CA must reject certificate requests for keys known to be compromised",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewCSRCompromisedKey,
+	})
+}
+
+func NewCSRCompromisedKey() lint.CertificateRequestLintInterface {
+	return &csrCompromisedKey{}
+}
+
+func (l *csrCompromisedKey) Configure() interface{} {
+	return l
+}
+
+func (l *csrCompromisedKey) CheckApplies(r *x509.CertificateRequest) bool {
+	return len(l.KeyBlocklistConfig.CompromisedKeys) > 0
+}
+
+func (l *csrCompromisedKey) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	return checkCompromisedKey(l.KeyBlocklistConfig, r.RawSubjectPublicKeyInfo, nil)
+}
@@ -0,0 +1,70 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if... The CA is aware of a
+demonstrated or proven method to easily compute the Applicant's Private Key
+based on the Public Key (such as a Debian weak key, see
+https://wiki.debian.org/SSLkeys).
+
+This is checked against the key of the certificate signing request itself, so
+that a request can be rejected before a certificate is issued. This lint is
+only applied when Debian weak key blocklists are configured by the
+DebianWeakKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type csrDebianWeakKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterCertificateRequestLint(&lint.CertificateRequestLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_csr_debian_weak_key",
+			Description:   "The CA must reject certificate requests for Debian weak keys",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewCSRDebianWeakKey,
+	})
+}
+
+func NewCSRDebianWeakKey() lint.CertificateRequestLintInterface {
+	return &csrDebianWeakKey{}
+}
+
+func (l *csrDebianWeakKey) Configure() interface{} {
+	return l
+}
+
+func (l *csrDebianWeakKey) CheckApplies(r *x509.CertificateRequest) bool {
+	_, ok := r.PublicKey.(*rsa.PublicKey)
+	return ok && len(l.KeyBlocklistConfig.DebianWeakKeys) > 0
+}
+
+func (l *csrDebianWeakKey) Execute(r *x509.CertificateRequest) *lint.LintResult {
+	return checkDebianWeakKey(l.KeyBlocklistConfig, r.PublicKey.(*rsa.PublicKey), nil)
+}
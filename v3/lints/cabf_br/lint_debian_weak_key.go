@@ -0,0 +1,91 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if...
+3. The CA has previously been made aware that the Applicant's Private Key has
+   suffered a Key Compromise...
+4. The CA is aware of a demonstrated or proven method to easily compute the
+   Applicant's Private Key based on the Public Key (such as a Debian weak key,
+   see https://wiki.debian.org/SSLkeys).
+
+This lint is only applied when Debian weak key blocklists are configured by
+the DebianWeakKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type debianWeakKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_debian_weak_key",
+			Description:   "The CA must reject certificate requests for Debian weak keys",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewDebianWeakKey,
+	})
+}
+
+func NewDebianWeakKey() lint.LintInterface {
+	return &debianWeakKey{}
+}
+
+func (l *debianWeakKey) Configure() interface{} {
+	return l
+}
+
+func (l *debianWeakKey) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && len(l.KeyBlocklistConfig.DebianWeakKeys) > 0
+}
+
+func (l *debianWeakKey) Execute(c *x509.Certificate) *lint.LintResult {
+	return checkDebianWeakKey(l.KeyBlocklistConfig, c.PublicKey.(*rsa.PublicKey), lint.AtField(lint.FieldSubjectPublicKeyInfo))
+}
+
+// checkDebianWeakKey checks the RSA public key of a certificate or certificate
+// signing request against the configured Debian weak key blocklists.
+func checkDebianWeakKey(config lint.KeyBlocklistConfig, key *rsa.PublicKey, location *lint.Location) *lint.LintResult {
+	blocklist, err := config.DebianWeakKeyBlocklist()
+	if err != nil {
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("Failed to read the Debian weak key blocklists: %s", err),
+		}
+	}
+	if blocklist.Contains(key) {
+		return &lint.LintResult{
+			Status:   lint.Error,
+			Details:  fmt.Sprintf("The RSA key with fingerprint %x is a Debian weak key", util.DebianWeakKeyFingerprint(key.N)),
+			Location: location,
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
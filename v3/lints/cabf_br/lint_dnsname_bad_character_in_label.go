@@ -50,15 +50,16 @@ func (l *DNSNameProperCharacters) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *DNSNameProperCharacters) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
 		if !l.CompiledExpression.MatchString(c.Subject.CommonName) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		if !l.CompiledExpression.MatchString(dns) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -57,13 +57,14 @@ func wildcardInLeftLabelIncorrect(domain string) bool {
 }
 
 func (l *DNSNameLeftLabelWildcardCheck) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	if wildcardInLeftLabelIncorrect(c.Subject.CommonName) {
-		return &lint.LintResult{Status: lint.Error}
+		findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 	}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		if wildcardInLeftLabelIncorrect(dns) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
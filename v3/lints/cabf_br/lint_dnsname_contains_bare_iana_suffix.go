@@ -15,12 +15,16 @@ package cabf_br
  */
 
 import (
+	"fmt"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
 )
 
-type dnsNameContainsBareIANASuffix struct{}
+type dnsNameContainsBareIANASuffix struct {
+	GTLDConfig lint.GTLDConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,20 +43,29 @@ func NewDnsNameContainsBareIANASuffix() lint.LintInterface {
 	return &dnsNameContainsBareIANASuffix{}
 }
 
+func (l *dnsNameContainsBareIANASuffix) Configure() interface{} {
+	return l
+}
+
 func (l *dnsNameContainsBareIANASuffix) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
 func (l *dnsNameContainsBareIANASuffix) Execute(c *x509.Certificate) *lint.LintResult {
+	tlds, err := l.GTLDConfig.TLDMap()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the gTLD snapshot: %s", err)}
+	}
+	var findings []lint.Finding
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		if util.IsInTLDMap(c.Subject.CommonName) {
-			return &lint.LintResult{Status: lint.Error}
+		if tlds.IsInTLDMap(c.Subject.CommonName) {
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	for _, dns := range c.DNSNames {
-		if util.IsInTLDMap(dns) {
-			return &lint.LintResult{Status: lint.Error}
+	for i, dns := range c.DNSNames {
+		if tlds.IsInTLDMap(dns) {
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
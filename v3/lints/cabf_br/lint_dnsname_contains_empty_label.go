@@ -56,15 +56,16 @@ func domainHasEmptyLabel(domain string) bool {
 }
 
 func (l *DNSNameEmptyLabel) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
 		if domainHasEmptyLabel(c.Subject.CommonName) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		if domainHasEmptyLabel(dns) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
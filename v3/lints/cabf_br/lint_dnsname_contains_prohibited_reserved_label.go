@@ -46,15 +46,17 @@ func (l *DNSNameContainsProhibitedReservedLabel) CheckApplies(c *x509.Certificat
 }
 
 func (l *DNSNameContainsProhibitedReservedLabel) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		labels := strings.Split(dns, ".")
 
 		for _, label := range labels {
 			if util.HasReservedLabelPrefix(label) && !util.HasXNLabelPrefix(label) {
-				return &lint.LintResult{Status: lint.Error}
+				findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
+				break
 			}
 		}
 	}
 
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -15,6 +15,7 @@ package cabf_br
  */
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zmap/zcrypto/x509"
@@ -22,7 +23,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type DNSNameHyphenInSLD struct{}
+type DNSNameHyphenInSLD struct {
+	PublicSuffixListConfig lint.PublicSuffixListConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -41,29 +44,44 @@ func NewDNSNameHyphenInSLD() lint.LintInterface {
 	return &DNSNameHyphenInSLD{}
 }
 
+func (l *DNSNameHyphenInSLD) Configure() interface{} {
+	return l
+}
+
 func (l *DNSNameHyphenInSLD) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
 func (l *DNSNameHyphenInSLD) Execute(c *x509.Certificate) *lint.LintResult {
+	psl, err := l.PublicSuffixListConfig.List()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the Public Suffix List: %s", err)}
+	}
+	var findings []lint.Finding
+	// unparseable records a name that could not be parsed, which makes the
+	// lint NA unless another name has a finding.
+	unparseable := false
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		domainInfo := c.GetParsedSubjectCommonName(false)
+		domainInfo := psl.ParsedSubjectCommonName(c)
 		if domainInfo.ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
-		}
-		if strings.HasPrefix(domainInfo.ParsedDomain.SLD, "-") || strings.HasSuffix(domainInfo.ParsedDomain.SLD, "-") {
-			return &lint.LintResult{Status: lint.Error}
+			unparseable = true
+		} else if strings.HasPrefix(domainInfo.ParsedDomain.SLD, "-") || strings.HasSuffix(domainInfo.ParsedDomain.SLD, "-") {
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	parsedSANDNSNames := c.GetParsedDNSNames(false)
-	for i := range c.GetParsedDNSNames(false) {
+	parsedSANDNSNames := psl.ParsedDNSNames(c)
+	for i := range parsedSANDNSNames {
 		if parsedSANDNSNames[i].ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
+			unparseable = true
+			continue
 		}
 		if strings.HasPrefix(parsedSANDNSNames[i].ParsedDomain.SLD, "-") ||
 			strings.HasSuffix(parsedSANDNSNames[i].ParsedDomain.SLD, "-") {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	if len(findings) == 0 && unparseable {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	return lint.ResultFromFindings(findings)
 }
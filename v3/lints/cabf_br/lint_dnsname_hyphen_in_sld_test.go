@@ -56,3 +56,16 @@ func TestDNSNamePrivatePublicSuffixNoHyphenInSLD(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+// TestDNSNameHyphenCNUnparseableSAN lints a certificate with a hyphen beginning
+// the SLD of its subject CommonName and a DNSName that is a bare public suffix,
+// which cannot be parsed, expecting the error for the CommonName not to be
+// discarded.
+func TestDNSNameHyphenCNUnparseableSAN(t *testing.T) {
+	inputPath := "dnsNameHyphenCNUnparseableSAN.pem"
+	expected := lint.Error
+	out := test.TestLint("e_dnsname_hyphen_in_sld", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -56,17 +56,18 @@ func labelLengthTooLong(domain string) bool {
 }
 
 func (l *DNSNameLabelLengthTooLong) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
 		labelTooLong := labelLengthTooLong(c.Subject.CommonName)
 		if labelTooLong {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		labelTooLong := labelLengthTooLong(dns)
 		if labelTooLong {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -15,12 +15,17 @@ package cabf_br
  */
 
 import (
+	"fmt"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
 )
 
-type DNSNameValidTLD struct{}
+type DNSNameValidTLD struct {
+	CABFBaselineRequirementsConfig lint.CABFBaselineRequirementsConfig
+	GTLDConfig                     lint.GTLDConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,20 +44,39 @@ func NewDNSNameValidTLD() lint.LintInterface {
 	return &DNSNameValidTLD{}
 }
 
+func (l *DNSNameValidTLD) Configure() interface{} {
+	return l
+}
+
 func (l *DNSNameValidTLD) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
 func (l *DNSNameValidTLD) Execute(c *x509.Certificate) *lint.LintResult {
+	tlds, err := l.GTLDConfig.TLDMap()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the gTLD snapshot: %s", err)}
+	}
+	var findings []lint.Finding
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		if !util.HasValidTLD(c.Subject.CommonName, c.NotBefore) {
-			return &lint.LintResult{Status: lint.Error}
+		if !l.hasValidTLD(tlds, c.Subject.CommonName, c) {
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
-	for _, dns := range c.DNSNames {
-		if !util.HasValidTLD(dns, c.NotBefore) {
-			return &lint.LintResult{Status: lint.Error}
+	for i, dns := range c.DNSNames {
+		if !l.hasValidTLD(tlds, dns, c) {
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
+}
+
+// hasValidTLD reports whether name has a TLD of tlds that was valid when c was
+// issued. Names within the internal domains of a CA that is not publicly
+// trusted are exempt.
+func (l *DNSNameValidTLD) hasValidTLD(tlds *util.TLDMap, name string, c *x509.Certificate) bool {
+	if l.CABFBaselineRequirementsConfig.PrivateHierarchy && l.CABFBaselineRequirementsConfig.IsInternalDomain(name) {
+		return true
+	}
+	return tlds.HasValidTLD(name, c.NotBefore)
 }
@@ -96,3 +96,88 @@ func TestDNSNameWithIPInCommonName(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+// TestDNSNameInternalDomain lints a certificate for a DNS name with an invalid
+// TLD that is within one of the configured internal domains, which is only
+// exempt for a CA that is not publicly trusted.
+func TestDNSNameInternalDomain(t *testing.T) {
+	inputPath := "dnsNameNotValidTLD.pem"
+	testCases := []struct {
+		name     string
+		config   string
+		expected lint.LintStatus
+	}{
+		{
+			name: "private hierarchy",
+			config: `
+[CABFBaselineRequirementsConfig]
+InternalDomains = ["com.ukei", "gov.us"]
+PrivateHierarchy = true
+`,
+			expected: lint.Pass,
+		},
+		{
+			name: "publicly trusted",
+			config: `
+[CABFBaselineRequirementsConfig]
+InternalDomains = ["com.ukei", "gov.us"]
+`,
+			expected: lint.Error,
+		},
+		{
+			name: "other internal domain",
+			config: `
+[CABFBaselineRequirementsConfig]
+InternalDomains = ["corp.example", "gov.us"]
+PrivateHierarchy = true
+`,
+			expected: lint.Error,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := test.TestLintWithConfig("e_dnsname_not_valid_tld", inputPath, tc.config)
+			if out.Status != tc.expected {
+				t.Errorf("%s: expected %s, got %s", inputPath, tc.expected, out.Status)
+			}
+		})
+	}
+}
+
+// TestDNSNameGTLDSnapshot lints certificates against a configured gTLD
+// snapshot in which ukei is delegated and uk was removed before the
+// certificates were issued.
+func TestDNSNameGTLDSnapshot(t *testing.T) {
+	config := `
+[GTLDConfig]
+GTLDSnapshot = "../../testdata/gtldSnapshot.json"
+`
+	testCases := []struct {
+		inputPath string
+		expected  lint.LintStatus
+	}{
+		{"dnsNameNotValidTLD.pem", lint.Pass},
+		{"dnsNameValidTLD.pem", lint.Error},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.inputPath, func(t *testing.T) {
+			out := test.TestLintWithConfig("e_dnsname_not_valid_tld", tc.inputPath, config)
+			if out.Status != tc.expected {
+				t.Errorf("%s: expected %s, got %s", tc.inputPath, tc.expected, out.Status)
+			}
+		})
+	}
+}
+
+func TestDNSNameGTLDSnapshotMissing(t *testing.T) {
+	config := `
+[GTLDConfig]
+GTLDSnapshot = "../../testdata/missingGTLDSnapshot.json"
+`
+	inputPath := "dnsNameValidTLD.pem"
+	expected := lint.Fatal
+	out := test.TestLintWithConfig("e_dnsname_not_valid_tld", inputPath, config)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
@@ -15,6 +15,7 @@ package cabf_br
  */
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zmap/zcrypto/x509"
@@ -22,7 +23,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type DNSNameUnderscoreInTRD struct{}
+type DNSNameUnderscoreInTRD struct {
+	PublicSuffixListConfig lint.PublicSuffixListConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -41,30 +44,45 @@ func NewDNSNameUnderscoreInTRD() lint.LintInterface {
 	return &DNSNameUnderscoreInTRD{}
 }
 
+func (l *DNSNameUnderscoreInTRD) Configure() interface{} {
+	return l
+}
+
 func (l *DNSNameUnderscoreInTRD) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
 func (l *DNSNameUnderscoreInTRD) Execute(c *x509.Certificate) *lint.LintResult {
+	psl, err := l.PublicSuffixListConfig.List()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the Public Suffix List: %s", err)}
+	}
+	var findings []lint.Finding
+	// unparseable records a name that could not be parsed, which makes the
+	// lint NA unless another name has a finding.
+	unparseable := false
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		domainInfo := c.GetParsedSubjectCommonName(false)
+		domainInfo := psl.ParsedSubjectCommonName(c)
 		if domainInfo.ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
-		}
-		if strings.Contains(domainInfo.ParsedDomain.TRD, "_") {
-			return &lint.LintResult{Status: lint.Warn}
+			unparseable = true
+		} else if strings.Contains(domainInfo.ParsedDomain.TRD, "_") {
+			findings = append(findings, lint.Finding{Status: lint.Warn, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
 
-	parsedSANDNSNames := c.GetParsedDNSNames(false)
-	for i := range c.GetParsedDNSNames(false) {
+	parsedSANDNSNames := psl.ParsedDNSNames(c)
+	for i := range parsedSANDNSNames {
 		if parsedSANDNSNames[i].ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
+			unparseable = true
+			continue
 		}
 		if strings.Contains(parsedSANDNSNames[i].ParsedDomain.TRD, "_") {
-			return &lint.LintResult{Status: lint.Warn}
+			findings = append(findings, lint.Finding{Status: lint.Warn, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
 
-	return &lint.LintResult{Status: lint.Pass}
+	if len(findings) == 0 && unparseable {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	return lint.ResultFromFindings(findings)
 }
@@ -15,12 +15,16 @@ package cabf_br
  */
 
 import (
+	"fmt"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
 )
 
-type DNSNameWildcardLeftofPublicSuffix struct{}
+type DNSNameWildcardLeftofPublicSuffix struct {
+	PublicSuffixListConfig lint.PublicSuffixListConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,31 +43,45 @@ func NewDNSNameWildcardLeftofPublicSuffix() lint.LintInterface {
 	return &DNSNameWildcardLeftofPublicSuffix{}
 }
 
+func (l *DNSNameWildcardLeftofPublicSuffix) Configure() interface{} {
+	return l
+}
+
 func (l *DNSNameWildcardLeftofPublicSuffix) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.DNSNamesExist(c)
 }
 
 func (l *DNSNameWildcardLeftofPublicSuffix) Execute(c *x509.Certificate) *lint.LintResult {
+	psl, err := l.PublicSuffixListConfig.List()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the Public Suffix List: %s", err)}
+	}
+	var findings []lint.Finding
+	// unparseable records a name that could not be parsed, which makes the
+	// lint NA unless another name has a finding.
+	unparseable := false
 	if c.Subject.CommonName != "" && !util.CommonNameIsIP(c) {
-		domainInfo := c.GetParsedSubjectCommonName(false)
+		domainInfo := psl.ParsedSubjectCommonName(c)
 		if domainInfo.ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
-		}
-
-		if domainInfo.ParsedDomain.SLD == "*" {
-			return &lint.LintResult{Status: lint.Notice}
+			unparseable = true
+		} else if domainInfo.ParsedDomain.SLD == "*" {
+			findings = append(findings, lint.Finding{Status: lint.Notice, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 		}
 	}
 
-	parsedSANDNSNames := c.GetParsedDNSNames(false)
-	for i := range c.GetParsedDNSNames(false) {
+	parsedSANDNSNames := psl.ParsedDNSNames(c)
+	for i := range parsedSANDNSNames {
 		if parsedSANDNSNames[i].ParseError != nil {
-			return &lint.LintResult{Status: lint.NA}
+			unparseable = true
+			continue
 		}
 
 		if parsedSANDNSNames[i].ParsedDomain.SLD == "*" {
-			return &lint.LintResult{Status: lint.Notice}
+			findings = append(findings, lint.Finding{Status: lint.Notice, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	if len(findings) == 0 && unparseable {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	return lint.ResultFromFindings(findings)
 }
@@ -38,3 +38,28 @@ func TestWildcardNotLeftOfPublicSuffix(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestWildcardLeftOfPublicSuffixConfiguredList(t *testing.T) {
+	// co.uk is in the private section of the configured list, so *.co.uk is
+	// not a wildcard to the left of a registry-controlled label.
+	config := `
+[PublicSuffixListConfig]
+PublicSuffixList = "../../testdata/publicSuffixList.dat"
+`
+	inputPath := "dnsNameWildcardLeftOfPublicSuffix.pem"
+	expected := lint.Pass
+	out := test.TestLintWithConfig("n_dnsname_wildcard_left_of_public_suffix", inputPath, config)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+
+	config = `
+[PublicSuffixListConfig]
+PublicSuffixList = "../../testdata/missingPublicSuffixList.dat"
+`
+	expected = lint.Fatal
+	out = test.TestLintWithConfig("n_dnsname_wildcard_left_of_public_suffix", inputPath, config)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
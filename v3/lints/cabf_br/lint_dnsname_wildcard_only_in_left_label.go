@@ -59,13 +59,14 @@ func wildcardNotInLeftLabel(domain string) bool {
 }
 
 func (l *DNSNameWildcardOnlyInLeftlabel) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	if wildcardNotInLeftLabel(c.Subject.CommonName) {
-		return &lint.LintResult{Status: lint.Error}
+		findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAttribute(util.CommonNameOID)})
 	}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		if wildcardNotInLeftLabel(dns) {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
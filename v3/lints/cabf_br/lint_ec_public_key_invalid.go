@@ -0,0 +1,81 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"strings"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ecPublicKeyInvalid struct{}
+
+/************************************************
+BRs: 6.1.6
+ECDSA: The CA SHOULD confirm the validity of all keys using either the ECC Full
+Public Key Validation Routine or the ECC Partial Public Key Validation Routine.
+[Source: Sections 5.6.2.3.2 and 5.6.2.3.3, respectively, of NIST SP 800-56A:
+Revision 2]
+
+The full public key validation routine of NIST SP 800-56A checks that the
+public key is not the point at infinity, that its coordinates are elements of
+the field, that it is on the curve and that it has the order of the curve.
+EdDSA public keys are validated in the same way, as described by NIST SP
+800-186, appendix D.1.3.
+************************************************/
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_ec_public_key_invalid",
+			Description:   "Elliptic curve public keys on P-256, P-384, P-521, Ed25519 and Ed448 should pass full public key validation",
+			Citation:      "BRs: 6.1.6",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewECPublicKeyInvalid,
+	})
+}
+
+func NewECPublicKeyInvalid() lint.LintInterface {
+	return &ecPublicKeyInvalid{}
+}
+
+// CheckApplies uses the encoded SubjectPublicKeyInfo rather than the parsed
+// public key, as Ed448 keys are not parsed.
+func (l *ecPublicKeyInvalid) CheckApplies(c *x509.Certificate) bool {
+	return util.ECPublicKeyCurve(c.RawSubjectPublicKeyInfo) != ""
+}
+
+func (l *ecPublicKeyInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	return checkECPublicKey(c.RawSubjectPublicKeyInfo)
+}
+
+// checkECPublicKey returns a Warn result describing the problems found by
+// full public key validation of the elliptic curve public key in spki. Key
+// validation is a SHOULD of BR 6.1.6, so invalid keys are not an Error.
+func checkECPublicKey(spki []byte) *lint.LintResult {
+	curve := util.ECPublicKeyCurve(spki)
+	if problems := util.ValidateECPublicKey(spki); len(problems) > 0 {
+		return &lint.LintResult{
+			Status:   lint.Warn,
+			Details:  "the " + curve + " public key is invalid: " + strings.Join(problems, "; "),
+			Location: lint.AtField(lint.FieldSubjectPublicKeyInfo),
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
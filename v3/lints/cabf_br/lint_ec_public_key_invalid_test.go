@@ -0,0 +1,85 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestECPublicKeyInvalid(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputPath      string
+		expectedStatus lint.LintStatus
+	}{
+		{
+			name:           "pass P-256",
+			inputPath:      "ecdsaP256.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "pass P-384",
+			inputPath:      "ecdsaP384.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "pass P-521",
+			inputPath:      "ecdsaP521.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "pass Ed25519",
+			inputPath:      "smime/ed25519_strict_valid_ku_august_2023.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "pass Ed448",
+			inputPath:      "ed448PublicKeyValid.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "error Ed25519 point of small order",
+			inputPath:      "ed25519PublicKeySmallOrder.pem",
+			expectedStatus: lint.Warn,
+		},
+		{
+			name:           "error Ed448 point of small order",
+			inputPath:      "ed448PublicKeySmallOrder.pem",
+			expectedStatus: lint.Warn,
+		},
+		{
+			name:           "NA P-224",
+			inputPath:      "ecdsaP224.pem",
+			expectedStatus: lint.NA,
+		},
+		{
+			name:           "NA RSA",
+			inputPath:      "rsaROCAVulnerable.pem",
+			expectedStatus: lint.NA,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := test.TestLint("w_ec_public_key_invalid", tc.inputPath)
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+		})
+	}
+}
@@ -45,7 +45,7 @@ func (l *eKUCrit) CheckApplies(c *x509.Certificate) bool {
 
 func (l *eKUCrit) Execute(c *x509.Certificate) *lint.LintResult {
 	if e := util.GetExtFromCert(c, util.EkuSynOid); e.Critical {
-		return &lint.LintResult{Status: lint.Error}
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtExtension(util.EkuSynOid)}
 	} else {
 		return &lint.LintResult{Status: lint.Pass}
 	}
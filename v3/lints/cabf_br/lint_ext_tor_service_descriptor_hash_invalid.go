@@ -24,7 +24,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type torServiceDescHashInvalid struct{}
+type torServiceDescHashInvalid struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -43,6 +45,10 @@ func NewTorServiceDescHashInvalid() lint.LintInterface {
 	return &torServiceDescHashInvalid{}
 }
 
+func (l *torServiceDescHashInvalid) Configure() interface{} {
+	return l
+}
+
 func (l *torServiceDescHashInvalid) Initialize() error {
 	// There is nothing to initialize for a torServiceDescHashInvalid linter.
 	return nil
@@ -55,7 +61,7 @@ func (l *torServiceDescHashInvalid) CheckApplies(c *x509.Certificate) bool {
 	ext := util.GetExtFromCert(c, util.BRTorServiceDescriptor)
 	return ext != nil || (util.IsSubscriberCert(c) &&
 		util.CertificateSubjInTLD(c, util.OnionTLD) &&
-		util.IsEV(c.PolicyIdentifiers)) &&
+		l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers)) &&
 		util.IsOnionV2Cert(c)
 }
 
@@ -211,7 +217,7 @@ func (l *torServiceDescHashInvalid) Execute(c *x509.Certificate) *lint.LintResul
 	// certificate don't have a TorServiceDescriptorHash for the eTLD+1 in the
 	// descriptorMap.
 	// See also https://github.com/cabforum/documents/issues/190
-	if util.IsEV(c.PolicyIdentifiers) {
+	if l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) {
 		for eTLDPlusOne, subjDomain := range onionETLDPlusOneMap {
 			if _, found := descriptorMap[eTLDPlusOne]; !found {
 				return failResult(
@@ -0,0 +1,131 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+// TestKeyBlocklistLints tests the lints that check the keys of certificates,
+// OCSP responder certificates and certificate signing requests against the
+// blocklists of the [KeyBlocklistConfig] configuration.
+func TestKeyBlocklistLints(t *testing.T) {
+	certificate := func(t *testing.T, lintName, inputPath, config string) *lint.LintResult {
+		return test.TestLintWithConfig(lintName, inputPath, config)
+	}
+	ocspResponse := func(t *testing.T, lintName, inputPath, config string) *lint.LintResult {
+		return test.TestOCSPResponseLintWithConfig(t, lintName, inputPath, config)
+	}
+	certificateRequest := func(t *testing.T, lintName, inputPath, config string) *lint.LintResult {
+		ctx, err := lint.NewConfigFromString(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return test.TestLintCertificateRequest(t, lintName, test.ReadTestCertificateRequest(t, inputPath), ctx)
+	}
+	lints := []struct {
+		name string
+		run  func(t *testing.T, lintName, inputPath, config string) *lint.LintResult
+		// blocklist is the setting of the [KeyBlocklistConfig] that the
+		// lint checks.
+		blocklist      string
+		blocklisted    string
+		notBlocklisted string
+		// notApplicable, if set, is an input to which the lint does not
+		// apply even when configured.
+		notApplicable string
+	}{
+		{
+			name:           "e_debian_weak_key",
+			run:            certificate,
+			blocklist:      "DebianWeakKeys",
+			blocklisted:    "blocklistedKey.pem",
+			notBlocklisted: "rsassapssWithSHA512.pem",
+		},
+		{
+			name:           "e_compromised_key",
+			run:            certificate,
+			blocklist:      "CompromisedKeys",
+			blocklisted:    "blocklistedKey.pem",
+			notBlocklisted: "rsassapssWithSHA512.pem",
+		},
+		{
+			name:           "e_ocsp_responder_debian_weak_key",
+			run:            ocspResponse,
+			blocklist:      "DebianWeakKeys",
+			blocklisted:    "ocspResponderBlocklistedKey",
+			notBlocklisted: "ocspResponderNotBlocklistedKey",
+			notApplicable:  "ocspThisUpdateNotAfterProducedAt",
+		},
+		{
+			name:           "e_ocsp_responder_compromised_key",
+			run:            ocspResponse,
+			blocklist:      "CompromisedKeys",
+			blocklisted:    "ocspResponderBlocklistedKey",
+			notBlocklisted: "ocspResponderNotBlocklistedKey",
+			notApplicable:  "ocspThisUpdateNotAfterProducedAt",
+		},
+		{
+			name:           "e_csr_debian_weak_key",
+			run:            certificateRequest,
+			blocklist:      "DebianWeakKeys",
+			blocklisted:    "csrBlocklistedKey.pem",
+			notBlocklisted: "csrNotBlocklistedKey.pem",
+			notApplicable:  "csrValid.pem",
+		},
+		{
+			name:           "e_csr_compromised_key",
+			run:            certificateRequest,
+			blocklist:      "CompromisedKeys",
+			blocklisted:    "csrBlocklistedKey.pem",
+			notBlocklisted: "csrNotBlocklistedKey.pem",
+		},
+	}
+	type testCase struct {
+		name      string
+		inputPath string
+		config    string
+		expected  lint.LintStatus
+	}
+	blocklistFiles := map[string]string{
+		"DebianWeakKeys":  "debianWeakKeys.txt",
+		"CompromisedKeys": "compromisedKeys.txt",
+	}
+	for _, l := range lints {
+		config := fmt.Sprintf("[KeyBlocklistConfig]\n%s = [\"../../testdata/%s\"]\n", l.blocklist, blocklistFiles[l.blocklist])
+		missing := fmt.Sprintf("[KeyBlocklistConfig]\n%s = [\"../../testdata/missing.txt\"]\n", l.blocklist)
+		testCases := []testCase{
+			{"not configured", l.blocklisted, "", lint.NA},
+			{"blocklisted", l.blocklisted, config, lint.Error},
+			{"not blocklisted", l.notBlocklisted, config, lint.Pass},
+			{"missing blocklist", l.blocklisted, missing, lint.Fatal},
+		}
+		if l.notApplicable != "" {
+			testCases = append(testCases, testCase{"not applicable", l.notApplicable, config, lint.NA})
+		}
+		for _, tc := range testCases {
+			t.Run(l.name+"/"+tc.name, func(t *testing.T) {
+				out := l.run(t, l.name, tc.inputPath, tc.config)
+				if out.Status != tc.expected {
+					t.Errorf("%s: expected %s, got %s: %s", tc.inputPath, tc.expected, out.Status, out.Details)
+				}
+			})
+		}
+	}
+}
@@ -48,13 +48,15 @@ func (l *NoUnderscoreBefore1_6_2) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *NoUnderscoreBefore1_6_2) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		if strings.Contains(dns, "_") {
-			return &lint.LintResult{
-				Status:  lint.Error,
-				Details: fmt.Sprintf("The DNS name '%s' contains an underscore (_) character", dns),
-			}
+			findings = append(findings, lint.Finding{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("The DNS name '%s' contains an underscore (_) character", dns),
+				Location: lint.AtSubjectAltName(c, util.DNSNameTag, i),
+			})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -0,0 +1,65 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+	"golang.org/x/crypto/ocsp"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if... The CA has previously been made
+aware that the Applicant's Private Key has suffered a Key Compromise...
+
+This applies equally to the keys of delegated OCSP responders, which are
+checked using the responder certificate included in an OCSP response. This
+lint is only applied when compromised key blocklists are configured by the
+CompromisedKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type ocspResponderCompromisedKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterOcspResponseLint(&lint.OcspResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_responder_compromised_key",
+			Description:   "The certificate of an OCSP responder must not be issued for a key known to be compromised",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewOCSPResponderCompromisedKey,
+	})
+}
+
+func NewOCSPResponderCompromisedKey() lint.OcspResponseLintInterface {
+	return &ocspResponderCompromisedKey{}
+}
+
+func (l *ocspResponderCompromisedKey) Configure() interface{} {
+	return l
+}
+
+func (l *ocspResponderCompromisedKey) CheckApplies(r *ocsp.Response) bool {
+	return r.Certificate != nil && len(l.KeyBlocklistConfig.CompromisedKeys) > 0
+}
+
+func (l *ocspResponderCompromisedKey) Execute(r *ocsp.Response) *lint.LintResult {
+	return checkCompromisedKey(l.KeyBlocklistConfig, r.Certificate.RawSubjectPublicKeyInfo, nil)
+}
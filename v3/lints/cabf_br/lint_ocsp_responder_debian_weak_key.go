@@ -0,0 +1,73 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+	"golang.org/x/crypto/ocsp"
+)
+
+/************************************************************************
+BRs: 6.1.1.3
+The CA SHALL reject a certificate request if... The CA is aware of a
+demonstrated or proven method to easily compute the Applicant's Private Key
+based on the Public Key (such as a Debian weak key, see
+https://wiki.debian.org/SSLkeys).
+
+This applies equally to the keys of delegated OCSP responders, which are
+checked using the responder certificate included in an OCSP response. This
+lint is only applied when Debian weak key blocklists are configured by the
+DebianWeakKeys of the [KeyBlocklistConfig] configuration.
+*************************************************************************/
+
+type ocspResponderDebianWeakKey struct {
+	KeyBlocklistConfig lint.KeyBlocklistConfig
+}
+
+func init() {
+	lint.RegisterOcspResponseLint(&lint.OcspResponseLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_ocsp_responder_debian_weak_key",
+			Description:   "The certificate of an OCSP responder must not be issued for a Debian weak key",
+			Citation:      "BRs: 6.1.1.3",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewOCSPResponderDebianWeakKey,
+	})
+}
+
+func NewOCSPResponderDebianWeakKey() lint.OcspResponseLintInterface {
+	return &ocspResponderDebianWeakKey{}
+}
+
+func (l *ocspResponderDebianWeakKey) Configure() interface{} {
+	return l
+}
+
+func (l *ocspResponderDebianWeakKey) CheckApplies(r *ocsp.Response) bool {
+	if r.Certificate == nil {
+		return false
+	}
+	_, ok := r.Certificate.PublicKey.(*rsa.PublicKey)
+	return ok && len(l.KeyBlocklistConfig.DebianWeakKeys) > 0
+}
+
+func (l *ocspResponderDebianWeakKey) Execute(r *ocsp.Response) *lint.LintResult {
+	return checkDebianWeakKey(l.KeyBlocklistConfig, r.Certificate.PublicKey.(*rsa.PublicKey), nil)
+}
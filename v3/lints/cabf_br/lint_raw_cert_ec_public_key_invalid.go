@@ -0,0 +1,58 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertECPublicKeyInvalid struct{}
+
+/************************************************
+BRs: 6.1.6
+ECDSA: The CA SHOULD confirm the validity of all keys using either the ECC Full
+Public Key Validation Routine or the ECC Partial Public Key Validation Routine.
+
+Certificates whose elliptic curve public key is not on its curve, is a
+compressed point or is given with explicit curve parameters cannot be parsed,
+so w_ec_public_key_invalid is never run against them. This lint validates the
+public key of those certificates instead.
+************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_raw_cert_ec_public_key_invalid",
+			Description:   "Elliptic curve public keys on P-256, P-384, P-521, Ed25519 and Ed448 should pass full public key validation",
+			Citation:      "BRs: 6.1.6",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewRawCertECPublicKeyInvalid,
+	})
+}
+
+func NewRawCertECPublicKeyInvalid() lint.RawCertificateLintInterface {
+	return &rawCertECPublicKeyInvalid{}
+}
+
+func (l *rawCertECPublicKeyInvalid) CheckApplies(raw *util.RawCertificate) bool {
+	return util.ECPublicKeyCurve(raw.SubjectPublicKeyInfo.FullBytes) != ""
+}
+
+func (l *rawCertECPublicKeyInvalid) Execute(raw *util.RawCertificate) *lint.LintResult {
+	return checkECPublicKey(raw.SubjectPublicKeyInfo.FullBytes)
+}
@@ -0,0 +1,82 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/pem"
+	"os"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestRawCertECPublicKeyInvalid(t *testing.T) {
+	testCases := []struct {
+		name           string
+		inputPath      string
+		expectedStatus lint.LintStatus
+		expectedDetail string
+	}{
+		{
+			name:           "pass P-256",
+			inputPath:      "ecdsaP256.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "error compressed point",
+			inputPath:      "ecPublicKeyCompressedPoint.pem",
+			expectedStatus: lint.Warn,
+			expectedDetail: "the P-256 public key is invalid: the point is compressed, rather than in the uncompressed form",
+		},
+		{
+			name:           "error explicit parameters",
+			inputPath:      "ecPublicKeyExplicitParameters.pem",
+			expectedStatus: lint.Warn,
+			expectedDetail: "the P-256 public key is invalid: the curve is given by explicit parameters, rather than by the named curve P-256",
+		},
+		{
+			name:           "error point not on the curve",
+			inputPath:      "ecPublicKeyNotOnCurve.pem",
+			expectedStatus: lint.Warn,
+			expectedDetail: "the P-256 public key is invalid: the point is not on the curve P-256",
+		},
+		{
+			name:           "NA RSA",
+			inputPath:      "rsaROCAVulnerable.pem",
+			expectedStatus: lint.NA,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := os.ReadFile("../../testdata/" + tc.inputPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			block, _ := pem.Decode(data)
+			if block == nil {
+				t.Fatalf("no PEM block in %s", tc.inputPath)
+			}
+			result := test.TestLintRawCertificate(t, "w_raw_cert_ec_public_key_invalid", block.Bytes, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+			if tc.expectedDetail != "" && result.Details != tc.expectedDetail {
+				t.Errorf("expected details %q was %q", tc.expectedDetail, result.Details)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ func init() {
 			Citation:      "BRs: 6.1.6",
 			Source:        lint.CABFBaselineRequirements,
 			EffectiveDate: util.CABV113Date,
+			Cost:          lint.ExpensiveCost,
 		},
 		Lint: NewRsaModSmallFactor,
 	})
@@ -46,7 +46,9 @@ var (
 	base32SubsetRegex = regexp.MustCompile(`^[a-zA-Z2-7]+$`)
 )
 
-type onionNotValid struct{}
+type onionNotValid struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 /*******************************************************************
 https://tools.ietf.org/html/rfc7686#section-1
@@ -94,6 +96,10 @@ func NewOnionNotValid() lint.LintInterface {
 	return &onionNotValid{}
 }
 
+func (l *onionNotValid) Configure() interface{} {
+	return l
+}
+
 // CheckApplies returns true if the certificate contains one or more subject
 // names ending in `.onion`.
 func (l *onionNotValid) CheckApplies(c *x509.Certificate) bool {
@@ -125,7 +131,7 @@ func (l *onionNotValid) Execute(c *x509.Certificate) *lint.LintResult {
 		onionDomain := labels[len(labels)-2]
 		if len(onionDomain) == onionV2Len {
 			// Onion v2 address. These are only permitted for EV, per BRs Appendix C.
-			if !util.IsEV(c.PolicyIdentifiers) {
+			if !l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) {
 				return &lint.LintResult{
 					Status: lint.Error,
 					Details: fmt.Sprintf("%q is a v2 address, but the certificate is not "+
@@ -22,7 +22,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type onionNotEV struct{}
+type onionNotEV struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -41,6 +43,10 @@ func NewOnionNotEV() lint.LintInterface {
 	return &onionNotEV{}
 }
 
+func (l *onionNotEV) Configure() interface{} {
+	return l
+}
+
 // This lint only applies for certificates issued before CA/Browser Forum
 // Ballot SC27, which permitted .onion within non-EV certificates
 func (l *onionNotEV) CheckApplies(c *x509.Certificate) bool {
@@ -59,7 +65,7 @@ func (l *onionNotEV) Execute(c *x509.Certificate) *lint.LintResult {
 	 * subjectAltName Extension or commonName field unless such Certificate was
 	 * issued in accordance with Appendix F of the EV Guidelines.
 	 */
-	if !util.IsEV(c.PolicyIdentifiers) {
+	if !l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) {
 		return &lint.LintResult{
 			Status: lint.Error,
 			Details: fmt.Sprintf(
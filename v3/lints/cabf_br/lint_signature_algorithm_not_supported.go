@@ -15,6 +15,8 @@ package cabf_br
  */
 
 import (
+	"fmt"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -52,7 +54,9 @@ var (
 	}
 )
 
-type signatureAlgorithmNotSupported struct{}
+type signatureAlgorithmNotSupported struct {
+	CABFBaselineRequirementsConfig lint.CABFBaselineRequirementsConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -71,6 +75,10 @@ func NewSignatureAlgorithmNotSupported() lint.LintInterface {
 	return &signatureAlgorithmNotSupported{}
 }
 
+func (l *signatureAlgorithmNotSupported) Configure() interface{} {
+	return l
+}
+
 func (l *signatureAlgorithmNotSupported) CheckApplies(c *x509.Certificate) bool {
 	return true
 }
@@ -83,6 +91,13 @@ func (l *signatureAlgorithmNotSupported) Execute(c *x509.Certificate) *lint.Lint
 	} else if warnSigAlgs[sigAlg] {
 		status = lint.Warn
 	}
+	// A CA may restrict itself to fewer algorithms than the BRs allow.
+	if status != lint.Error && !l.CABFBaselineRequirementsConfig.AcceptsSignatureAlgorithm(sigAlg, c.SignatureAlgorithmOID) {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: fmt.Sprintf("signature algorithm %s is not one of the accepted signature algorithms of the CA", sigAlg),
+		}
+	}
 	return &lint.LintResult{
 		Status: status,
 	}
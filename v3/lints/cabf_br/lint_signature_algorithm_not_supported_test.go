@@ -47,3 +47,25 @@ func TestSignatureAlgorithmRSAPSSWarn(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestSignatureAlgorithmNotAccepted(t *testing.T) {
+	inputPath := "sha1WithRSASignatureAlgorithm.pem"
+	testCases := []struct {
+		name     string
+		accepted string
+		expected lint.LintStatus
+	}{
+		{name: "by name", accepted: `["ECDSA-SHA384", "sha1-rsa"]`, expected: lint.Pass},
+		{name: "by OID", accepted: `["1.2.840.113549.1.1.5"]`, expected: lint.Pass},
+		{name: "not accepted", accepted: `["SHA256-RSA"]`, expected: lint.Error},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := "[CABFBaselineRequirementsConfig]\nAcceptedSignatureAlgorithms = " + tc.accepted + "\n"
+			out := test.TestLintWithConfig("e_signature_algorithm_not_supported", inputPath, config)
+			if out.Status != tc.expected {
+				t.Errorf("%s: expected %s, got %s", inputPath, tc.expected, out.Status)
+			}
+		})
+	}
+}
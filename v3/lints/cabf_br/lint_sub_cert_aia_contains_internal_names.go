@@ -15,6 +15,7 @@ package cabf_br
  */
 
 import (
+	"fmt"
 	"net"
 	"net/url"
 	"time"
@@ -24,7 +25,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type subCertAIAInternalName struct{}
+type subCertAIAInternalName struct {
+	GTLDConfig lint.GTLDConfig
+}
 
 /************************************************************************
 BRs: 7.1.2.10.3
@@ -53,11 +56,19 @@ func NewSubCertAIAInternalName() lint.LintInterface {
 	return &subCertAIAInternalName{}
 }
 
+func (l *subCertAIAInternalName) Configure() interface{} {
+	return l
+}
+
 func (l *subCertAIAInternalName) CheckApplies(c *x509.Certificate) bool {
 	return util.IsSubscriberCert(c) && util.IsExtInCert(c, util.AiaOID)
 }
 
 func (l *subCertAIAInternalName) Execute(c *x509.Certificate) *lint.LintResult {
+	tlds, err := l.GTLDConfig.TLDMap()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the gTLD snapshot: %s", err)}
+	}
 	for _, u := range c.OCSPServer {
 		purl, err := url.Parse(u)
 		if err != nil {
@@ -68,7 +79,7 @@ func (l *subCertAIAInternalName) Execute(c *x509.Certificate) *lint.LintResult {
 			continue
 		}
 
-		if !util.HasValidTLD(purl.Hostname(), time.Now()) {
+		if !tlds.HasValidTLD(purl.Hostname(), time.Now()) {
 			return &lint.LintResult{Status: lint.Warn}
 		}
 	}
@@ -82,7 +93,7 @@ func (l *subCertAIAInternalName) Execute(c *x509.Certificate) *lint.LintResult {
 			continue
 		}
 
-		if !util.HasValidTLD(purl.Hostname(), time.Now()) {
+		if !tlds.HasValidTLD(purl.Hostname(), time.Now()) {
 			return &lint.LintResult{Status: lint.Warn}
 		}
 	}
@@ -58,7 +58,11 @@ func (l *subCertBasicConstCrit) Execute(c *x509.Certificate) *lint.LintResult {
 		if e.Critical {
 			return &lint.LintResult{Status: lint.Pass}
 		} else {
-			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("Basic Constraints extension is present (%v) and marked as non-critical", e.Id)}
+			return &lint.LintResult{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("Basic Constraints extension is present (%v) and marked as non-critical", e.Id),
+				Location: lint.AtExtension(util.BasicConstOID),
+			}
 		}
 	}
 	return &lint.LintResult{Status: lint.Fatal, Details: "Error processing Basic Constraints extension"}
@@ -0,0 +1,92 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+BRs: 7.1.6.4
+Subscriber Certificates may assert policy identifiers defined by the Issuing
+CA, which must then be documented in the CA's Certificate Policy and/or
+Certification Practice Statement, in addition to a CA/Browser Forum reserved
+policy identifier.
+
+This lint is only applied when the policy identifiers of the CA are declared
+by the PolicyOIDs of the [CABFBaselineRequirementsConfig] configuration.
+*************************************************************************/
+
+type subCertPolicyNotDeclared struct {
+	CABFBaselineRequirementsConfig lint.CABFBaselineRequirementsConfig
+	CABFEVGuidelinesConfig         lint.CABFEVGuidelinesConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_sub_cert_policy_not_declared",
+			Description:   "Subscriber certificates must only assert CA/Browser Forum reserved policy identifiers, EV policy identifiers, or policy identifiers declared by the CA",
+			Citation:      "BRs: 7.1.6.4",
+			Source:        lint.CABFBaselineRequirements,
+			EffectiveDate: util.CABEffectiveDate,
+		},
+		Lint: NewSubCertPolicyNotDeclared,
+	})
+}
+
+func NewSubCertPolicyNotDeclared() lint.LintInterface {
+	return &subCertPolicyNotDeclared{}
+}
+
+func (l *subCertPolicyNotDeclared) Configure() interface{} {
+	return l
+}
+
+func (l *subCertPolicyNotDeclared) CheckApplies(c *x509.Certificate) bool {
+	return util.IsSubscriberCert(c) &&
+		len(l.CABFBaselineRequirementsConfig.PolicyOIDs) > 0 &&
+		len(c.PolicyIdentifiers) > 0
+}
+
+func (l *subCertPolicyNotDeclared) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, oid := range c.PolicyIdentifiers {
+		if isCABFReservedPolicy(oid) ||
+			l.CABFEVGuidelinesConfig.IsEV([]asn1.ObjectIdentifier{oid}) ||
+			l.CABFBaselineRequirementsConfig.IsDeclaredPolicy(oid) {
+			continue
+		}
+		findings = append(findings, lint.Finding{
+			Status:   lint.Error,
+			Details:  fmt.Sprintf("policy identifier %s is not declared by the CA", oid),
+			Location: lint.AtExtension(util.CertPolicyOID),
+		})
+	}
+	return lint.ResultFromFindings(findings)
+}
+
+// cabfPolicyArc is the arc of the policy identifiers reserved by the
+// CA/Browser Forum.
+var cabfPolicyArc = asn1.ObjectIdentifier{2, 23, 140, 1}
+
+func isCABFReservedPolicy(oid asn1.ObjectIdentifier) bool {
+	return len(oid) > len(cabfPolicyArc) && oid[:len(cabfPolicyArc)].Equal(cabfPolicyArc)
+}
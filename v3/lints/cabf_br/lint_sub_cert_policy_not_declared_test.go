@@ -0,0 +1,72 @@
+package cabf_br
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestSubCertPolicyNotDeclared(t *testing.T) {
+	// The certificate asserts 1.3.6.1.4.1.6449.1.2.1.3.4 and 2.23.140.1.2.2.
+	inputPath := "dnsNameWithIPInCN.pem"
+	testCases := []struct {
+		name     string
+		config   string
+		expected lint.LintStatus
+	}{
+		{
+			name:     "no declared policies",
+			expected: lint.NA,
+		},
+		{
+			name: "declared",
+			config: `
+[CABFBaselineRequirementsConfig]
+PolicyOIDs = ["1.3.6.1.4.1.6449.1.2.1.3.4"]
+`,
+			expected: lint.Pass,
+		},
+		{
+			name: "declared as EV",
+			config: `
+[CABFBaselineRequirementsConfig]
+PolicyOIDs = ["1.2.3.4"]
+
+[CABFEVGuidelinesConfig]
+EVPolicyOIDs = ["1.3.6.1.4.1.6449.1.2.1.3.4"]
+`,
+			expected: lint.Pass,
+		},
+		{
+			name: "not declared",
+			config: `
+[CABFBaselineRequirementsConfig]
+PolicyOIDs = ["1.2.3.4"]
+`,
+			expected: lint.Error,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := test.TestLintWithConfig("e_sub_cert_policy_not_declared", inputPath, tc.config)
+			if out.Status != tc.expected {
+				t.Errorf("%s: expected %s, got %s", inputPath, tc.expected, out.Status)
+			}
+		})
+	}
+}
@@ -17,7 +17,6 @@ package cabf_br
 import (
 	"fmt"
 
-	"github.com/zmap/zcrypto/encoding/asn1"
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -46,13 +45,21 @@ func (l *subjectRdnsCorrectEncoding) CheckApplies(c *x509.Certificate) bool {
 	return true
 }
 
+func (l *subjectRdnsCorrectEncoding) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *subjectRdnsCorrectEncoding) Execute(c *x509.Certificate) *lint.LintResult {
-	rdnSequence := util.RawRDNSequence{}
-	if rest, err := asn1.Unmarshal(c.RawSubject, &rdnSequence); err != nil || len(rest) > 0 {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *subjectRdnsCorrectEncoding) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	rdnSequence, rest, err := cc.RawSubject()
+	if err != nil || len(rest) > 0 {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
+	for i, attrTypeAndValueSet := range rdnSequence {
 		for _, attrTypeAndValue := range attrTypeAndValueSet {
 			oid := attrTypeAndValue.Type.String()
 			tag := attrTypeAndValue.Value.Tag
@@ -96,7 +103,11 @@ func (l *subjectRdnsCorrectEncoding) Execute(c *x509.Certificate) *lint.LintResu
 
 			for _, encodingError := range errors {
 				if encodingError != "" {
-					return &lint.LintResult{Status: lint.Error, Details: encodingError}
+					return &lint.LintResult{
+						Status:   lint.Error,
+						Details:  encodingError,
+						Location: lint.AtSubjectRDN(i, attrTypeAndValue.Type),
+					}
 				}
 			}
 
@@ -44,13 +44,15 @@ func (l *UnderscoreNotPermissibleInDNSName) CheckApplies(c *x509.Certificate) bo
 }
 
 func (l *UnderscoreNotPermissibleInDNSName) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		if strings.Contains(dns, "_") {
-			return &lint.LintResult{
-				Status:  lint.Error,
-				Details: fmt.Sprintf("The DNS name '%s' contains an underscore (_) character", dns),
-			}
+			findings = append(findings, lint.Finding{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("The DNS name '%s' contains an underscore (_) character", dns),
+				Location: lint.AtSubjectAltName(c, util.DNSNameTag, i),
+			})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
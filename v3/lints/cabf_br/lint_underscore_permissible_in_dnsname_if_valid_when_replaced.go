@@ -44,16 +44,22 @@ func (l *UnderscorePermissibleInDNSNameIfValidWhenReplaced) CheckApplies(c *x509
 }
 
 func (l *UnderscorePermissibleInDNSNameIfValidWhenReplaced) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		for _, label := range strings.Split(dns, ".") {
 			if !strings.Contains(label, "_") || label == "*" {
 				continue
 			}
 			replaced := strings.ReplaceAll(label, "_", "-")
 			if !util.IsLDHLabel(replaced) {
-				return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("When all underscores (_) in %q are replaced with hypens (-) the result is %q which not a valid LDH label", label, replaced)}
+				findings = append(findings, lint.Finding{
+					Status:   lint.Error,
+					Details:  fmt.Sprintf("When all underscores (_) in %q are replaced with hypens (-) the result is %q which not a valid LDH label", label, replaced),
+					Location: lint.AtSubjectAltName(c, util.DNSNameTag, i),
+				})
+				break
 			}
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
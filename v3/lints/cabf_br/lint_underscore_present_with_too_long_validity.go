@@ -45,17 +45,19 @@ func (l *UnderscorePresentWithTooLongValidity) CheckApplies(c *x509.Certificate)
 }
 
 func (l *UnderscorePresentWithTooLongValidity) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		if strings.Contains(dns, "_") {
-			return &lint.LintResult{
+			findings = append(findings, lint.Finding{
 				Status: lint.Error,
 				Details: fmt.Sprintf(
 					"The DNSName '%s' contains an underscore character which is only permissible if the certiticate is valid for less than 30 days (this certificate is valid for %d days)",
 					dns,
 					c.NotAfter.Sub(c.NotBefore)/util.DurationDay,
 				),
-			}
+				Location: lint.AtSubjectAltName(c, util.DNSNameTag, i),
+			})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
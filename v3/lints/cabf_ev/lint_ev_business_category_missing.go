@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evNoBiz struct{}
+type evNoBiz struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,8 +41,12 @@ func NewEvNoBiz() lint.LintInterface {
 	return &evNoBiz{}
 }
 
+func (l *evNoBiz) Configure() interface{} {
+	return l
+}
+
 func (l *evNoBiz) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 func (l *evNoBiz) Execute(c *x509.Certificate) *lint.LintResult {
@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evCountryMissing struct{}
+type evCountryMissing struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,8 +41,12 @@ func NewEvCountryMissing() lint.LintInterface {
 	return &evCountryMissing{}
 }
 
+func (l *evCountryMissing) Configure() interface{} {
+	return l
+}
+
 func (l *evCountryMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 func (l *evCountryMissing) Execute(c *x509.Certificate) *lint.LintResult {
@@ -38,14 +38,20 @@ func init() {
 	})
 }
 
-type invalidBusinessCategory struct{}
+type invalidBusinessCategory struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func NewInvalidBusinessCategory() lint.LintInterface {
 	return &invalidBusinessCategory{}
 }
 
+func (l *invalidBusinessCategory) Configure() interface{} {
+	return l
+}
+
 func (l *invalidBusinessCategory) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 func (l *invalidBusinessCategory) Execute(c *x509.Certificate) *lint.LintResult {
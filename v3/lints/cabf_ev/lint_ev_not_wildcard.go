@@ -36,18 +36,29 @@ func init() {
 	})
 }
 
-type EvNotWildCard struct{}
+type EvNotWildCard struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+	PublicSuffixListConfig lint.PublicSuffixListConfig
+}
 
 func NewEvNotWildCard() lint.LintInterface {
 	return &EvNotWildCard{}
 }
 
+func (l *EvNotWildCard) Configure() interface{} {
+	return l
+}
+
 func (l *EvNotWildCard) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers)
 }
 
 func (l *EvNotWildCard) Execute(c *x509.Certificate) *lint.LintResult {
-	names := append(c.GetParsedDNSNames(false), c.GetParsedSubjectCommonName(false))
+	psl, err := l.PublicSuffixListConfig.List()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the Public Suffix List: %s", err)}
+	}
+	names := append(psl.ParsedDNSNames(c), psl.ParsedSubjectCommonName(c))
 	for _, name := range names {
 		if name.ParseError != nil {
 			continue
@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evOrgIdExtMissing struct{}
+type evOrgIdExtMissing struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -40,8 +42,12 @@ func NewEvOrgIdExtMissing() lint.LintInterface {
 	return &evOrgIdExtMissing{}
 }
 
+func (l *evOrgIdExtMissing) Configure() interface{} {
+	return l
+}
+
 func (l *evOrgIdExtMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && len(c.Subject.OrganizationIDs) > 0
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && len(c.Subject.OrganizationIDs) > 0
 }
 
 func (l *evOrgIdExtMissing) Execute(c *x509.Certificate) *lint.LintResult {
@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evOrgMissing struct{}
+type evOrgMissing struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,8 +41,12 @@ func NewEvOrgMissing() lint.LintInterface {
 	return &evOrgMissing{}
 }
 
+func (l *evOrgMissing) Configure() interface{} {
+	return l
+}
+
 func (l *evOrgMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 func (l *evOrgMissing) Execute(c *x509.Certificate) *lint.LintResult {
@@ -86,16 +86,22 @@ func (o OrganizationIdentifier) regexForOrgID() *regexp.Regexp {
 	return regexp.MustCompile(pattern)
 }
 
-type orgIdInconsistentSubjAndExt struct{}
+type orgIdInconsistentSubjAndExt struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func NewOrgIdInconsistentSubjAndExt() lint.LintInterface {
 	return &orgIdInconsistentSubjAndExt{}
 }
 
+func (l *orgIdInconsistentSubjAndExt) Configure() interface{} {
+	return l
+}
+
 func (l *orgIdInconsistentSubjAndExt) CheckApplies(c *x509.Certificate) bool {
 	// It is actually mandatory that, if orgId is present, cabfOrgId be present as well,
 	// however this is already checked by another lint
-	return util.IsEV(c.PolicyIdentifiers) && (len(c.Subject.OrganizationIDs) > 0) &&
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && (len(c.Subject.OrganizationIDs) > 0) &&
 		util.IsExtInCert(c, util.CabfExtensionOrganizationIdentifier)
 }
 
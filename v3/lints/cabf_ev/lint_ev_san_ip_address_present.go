@@ -33,14 +33,20 @@ func init() {
 	})
 }
 
-type EvSanIpAddressPresent struct{}
+type EvSanIpAddressPresent struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func NewEvSanIpAddressPresent() lint.LintInterface {
 	return &EvSanIpAddressPresent{}
 }
 
+func (l *EvSanIpAddressPresent) Configure() interface{} {
+	return l
+}
+
 func (l *EvSanIpAddressPresent) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers)
 }
 
 func (l *EvSanIpAddressPresent) Execute(c *x509.Certificate) *lint.LintResult {
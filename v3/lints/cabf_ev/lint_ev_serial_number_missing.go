@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evSNMissing struct{}
+type evSNMissing struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,8 +41,12 @@ func NewEvSNMissing() lint.LintInterface {
 	return &evSNMissing{}
 }
 
+func (l *evSNMissing) Configure() interface{} {
+	return l
+}
+
 func (l *evSNMissing) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 func (l *evSNMissing) Execute(c *x509.Certificate) *lint.LintResult {
@@ -38,3 +38,24 @@ func TestEvNoSN(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+// TestEvNoSNDeclaredEVPolicy lints an OV certificate whose policy identifier
+// 1.3.6.1.4.1.6449.1.2.1.3.4 is only EV if the CA declares it to be.
+func TestEvNoSNDeclaredEVPolicy(t *testing.T) {
+	inputPath := "dnsNameWithIPInCN.pem"
+	expected := lint.NA
+	out := test.TestLint("e_ev_serial_number_missing", inputPath)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+
+	config := `
+[CABFEVGuidelinesConfig]
+EVPolicyOIDs = ["1.3.6.1.4.1.6449.1.2.1.3.4"]
+`
+	expected = lint.Error
+	out = test.TestLintWithConfig("e_ev_serial_number_missing", inputPath, config)
+	if out.Status != expected {
+		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+	}
+}
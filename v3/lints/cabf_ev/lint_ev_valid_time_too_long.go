@@ -20,7 +20,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type evValidTooLong struct{}
+type evValidTooLong struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -39,13 +41,17 @@ func NewEvValidTooLong() lint.LintInterface {
 	return &evValidTooLong{}
 }
 
+func (l *evValidTooLong) Configure() interface{} {
+	return l
+}
+
 func (l *evValidTooLong) CheckApplies(c *x509.Certificate) bool {
 	// CA/Browser Forum Ballot 193 changed the maximum validity period to be
 	// 825 days, which is more permissive than 27-month certificates, as that
 	// is 823 days.
 	return c.NotBefore.Before(util.SubCert825Days) &&
 		util.IsSubscriberCert(c) &&
-		util.IsEV(c.PolicyIdentifiers)
+		l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers)
 }
 
 func (l *evValidTooLong) Execute(c *x509.Certificate) *lint.LintResult {
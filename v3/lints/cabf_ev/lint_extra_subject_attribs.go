@@ -41,14 +41,20 @@ func init() {
 	})
 }
 
-type extraSubjectAttribs struct{}
+type extraSubjectAttribs struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func NewExtraSubjectAttribs() lint.LintInterface {
 	return &extraSubjectAttribs{}
 }
 
+func (l *extraSubjectAttribs) Configure() interface{} {
+	return l
+}
+
 func (l *extraSubjectAttribs) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c)
 }
 
 var allowedAttribs = map[string]bool{
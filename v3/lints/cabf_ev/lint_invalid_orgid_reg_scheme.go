@@ -37,14 +37,20 @@ func init() {
 	})
 }
 
-type invalidOrgIDRegistrationScheme struct{}
+type invalidOrgIDRegistrationScheme struct {
+	CABFEVGuidelinesConfig lint.CABFEVGuidelinesConfig
+}
 
 func NewInvalidOrgIDRegistrationScheme() lint.LintInterface {
 	return &invalidOrgIDRegistrationScheme{}
 }
 
+func (l *invalidOrgIDRegistrationScheme) Configure() interface{} {
+	return l
+}
+
 func (l *invalidOrgIDRegistrationScheme) CheckApplies(c *x509.Certificate) bool {
-	return util.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) && c.Subject.OrganizationIDs != nil
+	return l.CABFEVGuidelinesConfig.IsEV(c.PolicyIdentifiers) && util.IsSubscriberCert(c) && c.Subject.OrganizationIDs != nil
 }
 
 func (l *invalidOrgIDRegistrationScheme) Execute(c *x509.Certificate) *lint.LintResult {
@@ -15,6 +15,7 @@ package cabf_smime_br
  */
 
 import (
+	"fmt"
 	"net"
 	"net/url"
 	"time"
@@ -24,7 +25,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type smimeAIAContainsInternalNames struct{}
+type smimeAIAContainsInternalNames struct {
+	GTLDConfig lint.GTLDConfig
+}
 
 /************************************************************************
 BRs: 7.1.2.3c
@@ -54,11 +57,19 @@ func NewSMIMEAIAInternalName() lint.LintInterface {
 	return &smimeAIAContainsInternalNames{}
 }
 
+func (l *smimeAIAContainsInternalNames) Configure() interface{} {
+	return l
+}
+
 func (l *smimeAIAContainsInternalNames) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.AiaOID) && util.IsSubscriberCert(c) && util.IsSMIMEBRCertificate(c)
 }
 
 func (l *smimeAIAContainsInternalNames) Execute(c *x509.Certificate) *lint.LintResult {
+	tlds, err := l.GTLDConfig.TLDMap()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the gTLD snapshot: %s", err)}
+	}
 	for _, u := range c.OCSPServer {
 		purl, err := url.Parse(u)
 		if err != nil {
@@ -69,7 +80,7 @@ func (l *smimeAIAContainsInternalNames) Execute(c *x509.Certificate) *lint.LintR
 			continue
 		}
 
-		if !util.HasValidTLD(purl.Hostname(), time.Now()) {
+		if !tlds.HasValidTLD(purl.Hostname(), time.Now()) {
 			return &lint.LintResult{Status: lint.Warn}
 		}
 	}
@@ -83,7 +94,7 @@ func (l *smimeAIAContainsInternalNames) Execute(c *x509.Certificate) *lint.LintR
 			continue
 		}
 
-		if !util.HasValidTLD(purl.Hostname(), time.Now()) {
+		if !tlds.HasValidTLD(purl.Hostname(), time.Now()) {
 			return &lint.LintResult{Status: lint.Warn}
 		}
 	}
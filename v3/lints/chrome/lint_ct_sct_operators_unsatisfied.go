@@ -0,0 +1,80 @@
+package chrome
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type ctLogOperatorDiversity struct {
+	ChromeRootProgramPolicyConfig lint.ChromeRootProgramPolicyConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "n_chrome_ct_sct_operators_unsatisfied",
+			Description:   "Check if certificate has embedded SCTs from at least two log operators trusted by Chrome to meet Chrome CT Policy",
+			Citation:      "https://googlechrome.github.io/CertificateTransparency/ct_policy.html",
+			Source:        lint.ChromeRootProgramPolicy,
+			EffectiveDate: util.ChromeCTPolicyDate,
+		},
+		Lint: NewCTLogOperatorDiversity,
+	})
+}
+
+func NewCTLogOperatorDiversity() lint.LintInterface {
+	return &ctLogOperatorDiversity{}
+}
+
+func (l *ctLogOperatorDiversity) Configure() interface{} {
+	return l
+}
+
+// CheckApplies returns true for any TLS server subscriber certificates that are
+// not precertificates, provided that Chrome's CT log list is configured by the
+// CTLogList of the [ChromeRootProgramPolicyConfig] configuration.
+func (l *ctLogOperatorDiversity) CheckApplies(c *x509.Certificate) bool {
+	return l.ChromeRootProgramPolicyConfig.CTLogList != "" &&
+		util.IsSubscriberCert(c) &&
+		util.IsServerAuthCert(c) &&
+		!util.IsExtInCert(c, util.CtPoisonOID)
+}
+
+// Execute checks if the SCTs embedded in the provided certificate that count
+// towards Chrome's CT policy, as described for n_chrome_ct_sct_count_unsatisfied,
+// are from at least two distinct log operators according to Chrome's CT log
+// list, as resolved by [util.CTLogList.EmbeddedSCTOperators].
+func (l *ctLogOperatorDiversity) Execute(c *x509.Certificate) *lint.LintResult {
+	list, err := l.ChromeRootProgramPolicyConfig.LogList()
+	if err != nil {
+		return &lint.LintResult{
+			Status:  lint.Fatal,
+			Details: fmt.Sprintf("Failed to read Chrome's CT log list: %s", err),
+		}
+	}
+	operators := list.EmbeddedSCTOperators(c)
+	if operators.Satisfied() {
+		return &lint.LintResult{Status: lint.Pass}
+	}
+	return &lint.LintResult{
+		Status:  lint.Notice,
+		Details: operators.Details("Chrome"),
+	}
+}
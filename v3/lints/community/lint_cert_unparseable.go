@@ -0,0 +1,53 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package community
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type certUnparseable struct{}
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_cert_unparseable",
+			Description:   "Certificates must be able to be parsed, as otherwise no certificate lints can be executed",
+			Citation:      "RFC 5280: 4.1",
+			Source:        lint.Community,
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewCertUnparseable,
+	})
+}
+
+func NewCertUnparseable() lint.RawCertificateLintInterface {
+	return &certUnparseable{}
+}
+
+func (l *certUnparseable) CheckApplies(raw *util.RawCertificate) bool {
+	return true
+}
+
+// Execute reports the error encountered while parsing the certificate as a
+// Fatal result, as none of the certificate lints could be executed against it.
+func (l *certUnparseable) Execute(raw *util.RawCertificate) *lint.LintResult {
+	if _, err := x509.ParseCertificate(raw.Raw); err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: "unable to parse certificate: " + err.Error()}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,51 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package community
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestCertUnparseable(t *testing.T) {
+	der := test.ReadTestCert("ecdsaP256.pem").Raw
+	testCases := []struct {
+		name           string
+		der            []byte
+		expectedStatus lint.LintStatus
+	}{
+		{
+			name:           "pass parseable certificate",
+			der:            der,
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "fatal truncated certificate",
+			der:            der[:len(der)/2],
+			expectedStatus: lint.Fatal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := test.TestLintRawCertificate(t, "e_cert_unparseable", tc.der, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v", tc.expectedStatus, result.Status)
+			}
+		})
+	}
+}
@@ -49,8 +49,9 @@ func (l *uniqueRevokedCertificate) Execute(c *x509.RevocationList) *lint.LintRes
 	for _, rc := range c.RevokedCertificates {
 		if serials[rc.SerialNumber.String()] {
 			return &lint.LintResult{
-				Status:  lint.Warn,
-				Details: fmt.Sprintf("Revoked certificates list contains duplicate serial number: %x", rc.SerialNumber),
+				Status:   lint.Warn,
+				Details:  fmt.Sprintf("Revoked certificates list contains duplicate serial number: %x", rc.SerialNumber),
+				Location: lint.AtRevokedCertificate(rc.SerialNumber),
 			}
 		}
 		serials[rc.SerialNumber.String()] = true
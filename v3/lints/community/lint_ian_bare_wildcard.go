@@ -46,10 +46,11 @@ func (l *brIANBareWildcard) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *brIANBareWildcard) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.IANDNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.IANDNSNames {
 		if strings.HasSuffix(dns, "*") {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtIssuerAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -46,10 +46,11 @@ func (l *IANPubSuffix) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *IANPubSuffix) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.IANDNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.IANDNSNames {
 		if len(strings.Split(dns, ".")) < 3 {
-			return &lint.LintResult{Status: lint.Warn}
+			findings = append(findings, lint.Finding{Status: lint.Warn, Location: lint.AtIssuerAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
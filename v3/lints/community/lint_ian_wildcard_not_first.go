@@ -44,12 +44,14 @@ func (l *brIANWildcardFirst) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *brIANWildcardFirst) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.IANDNSNames {
+	var findings []lint.Finding
+	for j, dns := range c.IANDNSNames {
 		for i := 1; i < len(dns); i++ {
 			if dns[i] == '*' {
-				return &lint.LintResult{Status: lint.Error}
+				findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtIssuerAltName(c, util.DNSNameTag, j)})
+				break
 			}
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
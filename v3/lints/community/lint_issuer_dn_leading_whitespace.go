@@ -49,7 +49,7 @@ func (l *IssuerDNLeadingSpace) Execute(c *x509.Certificate) *lint.LintResult {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
 	if leading {
-		return &lint.LintResult{Status: lint.Warn}
+		return &lint.LintResult{Status: lint.Warn, Location: lint.AtField(lint.FieldIssuer)}
 	}
 	return &lint.LintResult{Status: lint.Pass}
 }
@@ -51,9 +51,9 @@ func (l *IssuerRDNHasMultipleAttribute) Execute(c *x509.Certificate) *lint.LintR
 	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
-	for _, rdn := range issuer {
+	for i, rdn := range issuer {
 		if len(rdn) > 1 {
-			return &lint.LintResult{Status: lint.Warn}
+			return &lint.LintResult{Status: lint.Warn, Location: lint.AtIssuerRDN(i, rdn[0].Type)}
 		}
 	}
 	return &lint.LintResult{Status: lint.Pass}
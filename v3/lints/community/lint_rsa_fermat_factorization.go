@@ -38,6 +38,7 @@ func init() {
 			Citation:      "Pierre de Fermat",
 			Source:        lint.Community,
 			EffectiveDate: util.ZeroDate,
+			Cost:          lint.ExpensiveCost,
 		},
 		Lint: NewFermatFactorization,
 	})
@@ -0,0 +1,117 @@
+package community
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"math/big"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rocaVulnerableKey struct{}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name: "e_rsa_roca_vulnerable_key",
+			Description: "RSA keys generated by the vulnerable Infineon RSA library are susceptible to factorization " +
+				"(ROCA, CVE-2017-15361, for more information please see https://crocs.fi.muni.cz/public/papers/rsa_ccs17)",
+			Citation:      "CVE-2017-15361",
+			Source:        lint.Community,
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewROCAVulnerableKey,
+	})
+}
+
+func NewROCAVulnerableKey() lint.LintInterface {
+	return &rocaVulnerableKey{}
+}
+
+func (l *rocaVulnerableKey) CheckApplies(c *x509.Certificate) bool {
+	_, ok := c.PublicKey.(*rsa.PublicKey)
+	return ok && c.PublicKeyAlgorithm == x509.RSA
+}
+
+func (l *rocaVulnerableKey) Execute(c *x509.Certificate) *lint.LintResult {
+	if hasROCAFingerprint(c.PublicKey.(*rsa.PublicKey).N) {
+		return &lint.LintResult{
+			Status:  lint.Error,
+			Details: "this certificate's RSA public key has the fingerprint of a key generated by the vulnerable Infineon RSA library (ROCA)",
+		}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+// The primes p generated by the vulnerable Infineon library are of the form
+//
+//	p = k * M + (65537^a mod M)
+//
+// where M is the product of the first n primes. A modulus N = pq is therefore,
+// modulo each prime that divides M, in the multiplicative subgroup generated by
+// 65537, which holds for a random modulus with negligible probability. Testing
+// the primes up to 167, which divide M for every key size, is the
+// fingerprinting method of the ROCA paper[0].
+//
+// [0]: https://crocs.fi.muni.cz/_media/public/papers/nemec_roca_ccs17_preprint.pdf
+var rocaPrimes = []int64{
+	3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71,
+	73, 79, 83, 89, 97, 101, 103, 107, 109, 113, 127, 131, 137, 139, 149,
+	151, 157, 163, 167,
+}
+
+// rocaResidues holds, for each of the rocaPrimes, a bitmask of the residues
+// modulo that prime that are in the subgroup generated by 65537.
+var rocaResidues = func() []*big.Int {
+	residues := make([]*big.Int, len(rocaPrimes))
+	for i, p := range rocaPrimes {
+		mask := new(big.Int)
+		g := 65537 % p
+		for r := int64(1); mask.Bit(int(r)) == 0; r = r * g % p {
+			mask.SetBit(mask, int(r), 1)
+		}
+		residues[i] = mask
+	}
+	return residues
+}()
+
+// rocaModulus is the product of the rocaPrimes, so that a modulus needs to be
+// reduced only once before it is checked against each prime.
+var rocaModulus = func() *big.Int {
+	m := big.NewInt(1)
+	for _, p := range rocaPrimes {
+		m.Mul(m, big.NewInt(p))
+	}
+	return m
+}()
+
+// hasROCAFingerprint returns true if the modulus n has the fingerprint of a key
+// generated by the vulnerable Infineon library.
+func hasROCAFingerprint(n *big.Int) bool {
+	reduced := new(big.Int).Mod(n, rocaModulus)
+	prime := new(big.Int)
+	residue := new(big.Int)
+	for i, p := range rocaPrimes {
+		prime.SetInt64(p)
+		residue.Mod(reduced, prime)
+		if rocaResidues[i].Bit(int(residue.Int64())) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package community
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestHasROCAFingerprint(t *testing.T) {
+	data := []struct {
+		name string
+		n    *big.Int
+		want bool
+	}{
+		{
+			name: "ROCA",
+			// p and q were generated as k * M + (65537^a mod M).
+			n:    bigIntOrDie("16396308275035038659448789040111684989595097679655107954339960482669300766498179441030717402047618079993208124832004472070224227845335969497571853443175981007801970545281100461558490206988370600514481026848682722864035858925450168908500138503114255670461820928228586275516463145353941077984144280487622445250359417081816763274482907244401514675395358542864399035290384111650533887795148092310038477158134286771787664920885529144734181188458672668364358097803453273773704136443324190024354128633120578319486227782173407449085932261791688519730684389296684110914121932768544599722464010245092392824776000578598965906247"),
+			want: true,
+		},
+		{
+			name: "ROCA small",
+			// 65537 * 65537^2 = 65537^3
+			n:    big.NewInt(0).Exp(big.NewInt(65537), big.NewInt(3), nil),
+			want: true,
+		},
+		{
+			name: "Random",
+			n: big.NewInt(0).Mul(
+				bigIntOrDie("11779932606551869095289494662458707049283241949932278009554252037480401854504909149712949171865707598142483830639739537075502512627849249573564209082969463"),
+				bigIntOrDie("11779932606551869095289494662458707049283241949932278009554252037480401854503793357623711855670284027157475142731886267090836872063809791989556295953329083")),
+			want: false,
+		},
+		{
+			name: "Small",
+			n:    big.NewInt(5959),
+			want: false,
+		},
+	}
+	for _, test := range data {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if got := hasROCAFingerprint(test.n); got != test.want {
+				t.Errorf("hasROCAFingerprint(%s) = %t, want %t", test.n, got, test.want)
+			}
+		})
+	}
+}
+
+func TestROCAVulnerableKey(t *testing.T) {
+	data := map[string]lint.LintStatus{
+		"rsaROCAVulnerable.pem":                 lint.Error,
+		"rsassapssWithSHA512.pem":               lint.Pass,
+		"rsaFermatFactorizationSusceptible.pem": lint.Pass,
+		"ecdsaP256.pem":                         lint.NA,
+	}
+	for inputPath, expected := range data {
+		inputPath, expected := inputPath, expected
+		t.Run(inputPath, func(t *testing.T) {
+			out := test.TestLint("e_rsa_roca_vulnerable_key", inputPath)
+			if out.Status != expected {
+				t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
+			}
+		})
+	}
+}
+
+func BenchmarkROCAVulnerableKey_Execute(b *testing.B) {
+	// A vulnerable key is the worst case, as every prime must be checked.
+	cert := test.ReadTestCert("rsaROCAVulnerable.pem")
+	l := &rocaVulnerableKey{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Execute(cert)
+	}
+}
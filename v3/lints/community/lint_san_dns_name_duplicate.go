@@ -15,6 +15,7 @@
 package community
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zmap/zcrypto/x509"
@@ -46,15 +47,21 @@ func (l *SANDNSDuplicate) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSDuplicate) Execute(c *x509.Certificate) *lint.LintResult {
+	var findings []lint.Finding
 	checkedDNSNames := map[string]struct{}{}
-	for _, dns := range c.DNSNames {
+	for i, dns := range c.DNSNames {
 		normalizedDNSName := strings.ToLower(dns)
 		if _, isPresent := checkedDNSNames[normalizedDNSName]; isPresent {
-			return &lint.LintResult{Status: lint.Notice}
+			findings = append(findings, lint.Finding{
+				Status:   lint.Notice,
+				Details:  fmt.Sprintf("DNSName %q is a duplicate", dns),
+				Location: lint.AtSubjectAltName(c, util.DNSNameTag, i),
+			})
+			continue
 		}
 
 		checkedDNSNames[normalizedDNSName] = struct{}{}
 	}
 
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
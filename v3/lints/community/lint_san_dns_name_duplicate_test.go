@@ -29,3 +29,14 @@ func TestBrSANDNSDuplicate(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestBrSANDNSDuplicateFindings(t *testing.T) {
+	inputPath := "SANDNSDuplicate.pem"
+	out := test.TestLint("n_san_dns_name_duplicate", inputPath)
+	if len(out.Findings) != 1 {
+		t.Fatalf("%s: expected 1 finding, got %d", inputPath, len(out.Findings))
+	}
+	if expected := "extensions[2.5.29.17][1 dNSName]"; out.Findings[0].Location.String() != expected {
+		t.Errorf("%s: expected finding at %s, got %s", inputPath, expected, out.Findings[0].Location)
+	}
+}
@@ -44,12 +44,14 @@ func (l *SANDNSNull) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *SANDNSNull) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for j, dns := range c.DNSNames {
 		for i := 0; i < len(dns); i++ {
 			if dns[i] == 0 {
-				return &lint.LintResult{Status: lint.Error}
+				findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, j)})
+				break
 			}
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -38,3 +38,12 @@ func TestBrSANDNSNotPeriod(t *testing.T) {
 		t.Errorf("%s: expected %s, got %s", inputPath, expected, out.Status)
 	}
 }
+
+func TestBrSANDNSStartsWithPeriodLocation(t *testing.T) {
+	inputPath := "SANDNSPeriod.pem"
+	expected := "extensions[2.5.29.17][0 dNSName]"
+	out := test.TestLint("e_san_dns_name_starts_with_period", inputPath)
+	if out.Location.String() != expected {
+		t.Errorf("%s: expected location %s, got %s", inputPath, expected, out.Location)
+	}
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/zmap/zlint/v3/util"
 )
 
-type pubSuffix struct{}
+type pubSuffix struct {
+	PublicSuffixListConfig lint.PublicSuffixListConfig
+}
 
 func init() {
 	lint.RegisterCertificateLint(&lint.CertificateLint{
@@ -42,13 +44,21 @@ func NewPubSuffix() lint.LintInterface {
 	return &pubSuffix{}
 }
 
+func (l *pubSuffix) Configure() interface{} {
+	return l
+}
+
 func (l *pubSuffix) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
 }
 
 func (l *pubSuffix) Execute(c *x509.Certificate) *lint.LintResult {
+	psl, err := l.PublicSuffixListConfig.List()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("Failed to read the Public Suffix List: %s", err)}
+	}
 	var badNames []string
-	for _, parsedName := range c.GetParsedDNSNames(false) {
+	for _, parsedName := range psl.ParsedDNSNames(c) {
 		if parseErr := parsedName.ParseError; parseErr == nil {
 			continue
 		} else if strings.HasSuffix(parseErr.Error(), "is a suffix") {
@@ -63,3 +63,19 @@ func TestPubSuffix(t *testing.T) {
 		})
 	}
 }
+
+func TestPubSuffixConfiguredList(t *testing.T) {
+	// co.uk is in the private section of the configured list, so only ca, which
+	// is a public suffix by the default "*" rule, is reported.
+	config := `
+[PublicSuffixListConfig]
+PublicSuffixList = "../../testdata/publicSuffixList.dat"
+`
+	result := test.TestLintWithConfig("n_san_iana_pub_suffix_empty", "multiEmptyPubSuffix.pem", config)
+	if result.Status != lint.Notice {
+		t.Errorf("expected status %v was %v", lint.Notice, result.Status)
+	}
+	if expected := "1 DNS name(s) are bare public suffixes: ca"; result.Details != expected {
+		t.Errorf("expected details %v was %v", expected, result.Details)
+	}
+}
@@ -53,8 +53,9 @@ func (l *subjCountryNotUppercase) Execute(c *x509.Certificate) *lint.LintResult
 	for _, cc := range c.Subject.Country {
 		if !re.MatchString(cc) {
 			return &lint.LintResult{
-				Status:  lint.Error,
-				Details: "Country codes must be comprised of uppercase A-Z letters",
+				Status:   lint.Error,
+				Details:  "Country codes must be comprised of uppercase A-Z letters",
+				Location: lint.AtSubjectAttribute(util.CountryNameOID),
 			}
 		}
 	}
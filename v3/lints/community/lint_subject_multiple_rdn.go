@@ -50,9 +50,9 @@ func (l *SubjectRDNHasMultipleAttribute) Execute(c *x509.Certificate) *lint.Lint
 	if _, err := asn1.Unmarshal(c.RawSubject, &subject); err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
-	for _, rdn := range subject {
+	for i, rdn := range subject {
 		if len(rdn) > 1 {
-			return &lint.LintResult{Status: lint.Notice}
+			return &lint.LintResult{Status: lint.Notice, Location: lint.AtSubjectRDN(i, rdn[0].Type)}
 		}
 	}
 	return &lint.LintResult{Status: lint.Pass}
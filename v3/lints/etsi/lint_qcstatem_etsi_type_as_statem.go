@@ -46,9 +46,16 @@ func (l *qcStatemEtsiTypeAsStatem) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.QcStateOid)
 }
 
+func (l *qcStatemEtsiTypeAsStatem) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *qcStatemEtsiTypeAsStatem) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemEtsiTypeAsStatem) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
 
 	oidList := make([]*asn1.ObjectIdentifier, 3)
 	oidList[0] = &util.IdEtsiQcsQctEsign
@@ -56,7 +63,7 @@ func (l *qcStatemEtsiTypeAsStatem) Execute(c *x509.Certificate) *lint.LintResult
 	oidList[2] = &util.IdEtsiQcsQctWeb
 
 	for _, oid := range oidList {
-		r := util.ParseQcStatem(ext.Value, *oid)
+		r := cc.QcStatement(*oid)
 		util.AppendToStringSemicolonDelim(&errString, r.GetErrorInfo())
 		if r.IsPresent() {
 			util.AppendToStringSemicolonDelim(&errString, fmt.Sprintf("ETSI QC Type OID %v used as QC statement", oid))
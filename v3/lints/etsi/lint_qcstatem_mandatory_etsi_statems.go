@@ -50,15 +50,22 @@ func (l *qcStatemQcmandatoryEtsiStatems) CheckApplies(c *x509.Certificate) bool
 	return false
 }
 
+func (l *qcStatemQcmandatoryEtsiStatems) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *qcStatemQcmandatoryEtsiStatems) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcmandatoryEtsiStatems) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
 
 	oidList := make([]*asn1.ObjectIdentifier, 1)
 	oidList[0] = &util.IdEtsiQcsQcCompliance
 
 	for _, oid := range oidList {
-		r := util.ParseQcStatem(ext.Value, *oid)
+		r := cc.QcStatement(*oid)
 		util.AppendToStringSemicolonDelim(&errString, r.GetErrorInfo())
 		if !r.IsPresent() {
 			util.AppendToStringSemicolonDelim(&errString, "missing mandatory ETSI QC statement")
@@ -45,20 +45,28 @@ func (this *qcStatemQcComplianceValid) getStatementOid() *asn1.ObjectIdentifier
 }
 
 func (l *qcStatemQcComplianceValid) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcComplianceValid) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+	if cc.QcStatement(*l.getStatementOid()).IsPresent() {
 		return true
 	}
 	return false
 }
 
 func (l *qcStatemQcComplianceValid) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcComplianceValid) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	s := cc.QcStatement(*l.getStatementOid())
 	errString += s.GetErrorInfo()
 	if len(errString) == 0 {
 		return &lint.LintResult{Status: lint.Pass}
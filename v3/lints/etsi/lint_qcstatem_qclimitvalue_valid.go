@@ -47,10 +47,15 @@ func (this *qcStatemQcLimitValueValid) getStatementOid() *asn1.ObjectIdentifier
 }
 
 func (l *qcStatemQcLimitValueValid) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcLimitValueValid) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+	if cc.QcStatement(*l.getStatementOid()).IsPresent() {
 		return true
 	}
 	return false
@@ -66,10 +71,13 @@ func isOnlyLetters(s string) bool {
 }
 
 func (l *qcStatemQcLimitValueValid) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcLimitValueValid) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	s := cc.QcStatement(*l.getStatementOid())
 	errString += s.GetErrorInfo()
 	if len(errString) == 0 {
 		qcLv, ok := s.(util.EtsiQcLimitValue)
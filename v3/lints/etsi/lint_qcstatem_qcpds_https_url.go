@@ -80,20 +80,28 @@ func NewQcStatemPdsHasHTTPSOnly() lint.LintInterface {
 }
 
 func (l *qcStatemPdsHttpsOnly) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemPdsHttpsOnly) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	qcEuPDS := &util.IdEtsiQcsQcEuPDS
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *qcEuPDS).IsPresent() {
+	if cc.QcStatement(*qcEuPDS).IsPresent() {
 		return true
 	}
 	return false
 }
 
 func (l *qcStatemPdsHttpsOnly) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemPdsHttpsOnly) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, util.IdEtsiQcsQcEuPDS)
+	s := cc.QcStatement(util.IdEtsiQcsQcEuPDS)
 
 	errString := s.GetErrorInfo()
 
@@ -48,10 +48,15 @@ func (this *qcStatemQcPdsLangCase) getStatementOid() *asn1.ObjectIdentifier {
 }
 
 func (l *qcStatemQcPdsLangCase) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcPdsLangCase) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+	if cc.QcStatement(*l.getStatementOid()).IsPresent() {
 		return true
 	}
 	return false
@@ -67,10 +72,13 @@ func isOnlyLowerCaseLetters(s string) bool {
 }
 
 func (l *qcStatemQcPdsLangCase) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcPdsLangCase) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 	errString := ""
 	wrnString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	s := cc.QcStatement(*l.getStatementOid())
 	errString += s.GetErrorInfo()
 	if len(errString) == 0 {
 		pds := s.(util.EtsiQcPds)
@@ -48,10 +48,15 @@ func (this *qcStatemQcPdsValid) getStatementOid() *asn1.ObjectIdentifier {
 }
 
 func (l *qcStatemQcPdsValid) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcPdsValid) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+	if cc.QcStatement(*l.getStatementOid()).IsPresent() {
 		return true
 	}
 	return false
@@ -67,9 +72,12 @@ func isInList(s string, list []string) bool {
 }
 
 func (l *qcStatemQcPdsValid) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcPdsValid) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	s := cc.QcStatement(*l.getStatementOid())
 	errString += s.GetErrorInfo()
 	if len(errString) == 0 {
 		codeList := make([]string, 0)
@@ -45,20 +45,28 @@ func (this *qcStatemQcRetentionPeriodValid) getStatementOid() *asn1.ObjectIdenti
 }
 
 func (l *qcStatemQcRetentionPeriodValid) CheckApplies(c *x509.Certificate) bool {
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcRetentionPeriodValid) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	c := cc.Certificate
 	if !util.IsExtInCert(c, util.QcStateOid) {
 		return false
 	}
-	if util.ParseQcStatem(util.GetExtFromCert(c, util.QcStateOid).Value, *l.getStatementOid()).IsPresent() {
+	if cc.QcStatement(*l.getStatementOid()).IsPresent() {
 		return true
 	}
 	return false
 }
 
 func (l *qcStatemQcRetentionPeriodValid) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *qcStatemQcRetentionPeriodValid) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 
 	errString := ""
-	ext := util.GetExtFromCert(c, util.QcStateOid)
-	s := util.ParseQcStatem(ext.Value, *l.getStatementOid())
+	s := cc.QcStatement(*l.getStatementOid())
 	errString += s.GetErrorInfo()
 	if len(errString) == 0 {
 
@@ -0,0 +1,68 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type certNotDER struct{}
+
+/************************************************
+RFC 5280: 4.1
+   To calculate the signature, the data that is to be signed is encoded
+   using the ASN.1 distinguished encoding rules (DER) [X.690].
+************************************************/
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_cert_not_der",
+			Description:   "Every element of a certificate, including the values of its extensions, must be DER encoded",
+			Citation:      "RFC 5280: 4.1 and X.690: 10 and 11",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewCertNotDER,
+	})
+}
+
+func NewCertNotDER() lint.CertificateLintInterface {
+	return &certNotDER{}
+}
+
+func (l *certNotDER) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *certNotDER) Execute(c *x509.Certificate) *lint.LintResult {
+	return derProblemsResult(util.CheckCertificateDER(c.Raw))
+}
+
+// derProblemsResult returns a result holding an Error finding for each of the
+// problems, which point at the offending elements by their ASN.1 path.
+func derProblemsResult(problems []util.DERProblem) *lint.LintResult {
+	findings := make([]lint.Finding, 0, len(problems))
+	for _, p := range problems {
+		findings = append(findings, lint.Finding{
+			Status:   lint.Error,
+			Details:  p.Path + ": " + p.Description,
+			Location: lint.AtASN1Path(p.Path, p.Offset),
+		})
+	}
+	return lint.ResultFromFindings(findings)
+}
@@ -0,0 +1,55 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestCertNotDER(t *testing.T) {
+	testCases := []struct {
+		name             string
+		filepath         string
+		expectedStatus   lint.LintStatus
+		expectedLocation string
+	}{
+		{
+			name:           "pass DER encoded certificate",
+			filepath:       "ecdsaP256.pem",
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:             "error certificate with an extension explicitly marked as not critical",
+			filepath:         "cert_ext_invalid_der_ko_01.pem",
+			expectedStatus:   lint.Error,
+			expectedLocation: "tbsCertificate.extensions[2.5.29.17].critical@855",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := test.TestLint("e_cert_not_der", tc.filepath)
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+			if location := result.Location.String(); location != tc.expectedLocation {
+				t.Errorf("expected location %q, got %q", tc.expectedLocation, location)
+			}
+		})
+	}
+}
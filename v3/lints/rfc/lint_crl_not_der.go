@@ -0,0 +1,54 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type crlNotDER struct{}
+
+/************************************************
+RFC 5280: 5.1
+   To calculate the signature, the data that is to be signed is ASN.1 DER
+   encoded.
+************************************************/
+
+func init() {
+	lint.RegisterRevocationListLint(&lint.RevocationListLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_crl_not_der",
+			Description:   "Every element of a CRL, including the values of its extensions and those of its entries, must be DER encoded",
+			Citation:      "RFC 5280: 5.1 and X.690: 10 and 11",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewCrlNotDER,
+	})
+}
+
+func NewCrlNotDER() lint.RevocationListLintInterface {
+	return &crlNotDER{}
+}
+
+func (l *crlNotDER) CheckApplies(c *x509.RevocationList) bool {
+	return true
+}
+
+func (l *crlNotDER) Execute(c *x509.RevocationList) *lint.LintResult {
+	return derProblemsResult(util.CheckRevocationListDER(c.Raw))
+}
@@ -0,0 +1,46 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestCrlNotDER(t *testing.T) {
+	crl := test.ReadTestRevocationList(t, "crlWithAuthKeyID.pem")
+	result := test.TestLintRevocationList(t, "e_crl_not_der", crl, lint.NewEmptyConfig())
+	if result.Status != lint.Pass {
+		t.Errorf("expected result %v was %v (%s)", lint.Pass, result.Status, result.Details)
+	}
+
+	// Re-encode the outer SEQUENCE with a length that has a leading zero octet.
+	var outer asn1.RawValue
+	if _, err := asn1.Unmarshal(crl.Raw, &outer); err != nil {
+		t.Fatal(err)
+	}
+	n := len(outer.Bytes)
+	crl.Raw = append([]byte{0x30, 0x84, 0, 0, byte(n >> 8), byte(n)}, outer.Bytes...)
+	result = test.TestLintRevocationList(t, "e_crl_not_der", crl, lint.NewEmptyConfig())
+	if result.Status != lint.Error {
+		t.Errorf("expected result %v was %v", lint.Error, result.Status)
+	}
+	if location := result.Location.String(); location != "certificateList@0" {
+		t.Errorf("expected location %q, got %q", "certificateList@0", location)
+	}
+}
@@ -63,10 +63,18 @@ func (l *crlHasValidReasonCode) Execute(c *x509.RevocationList) *lint.LintResult
 		}
 		code := *c.ReasonCode
 		if code == 0 {
-			return &lint.LintResult{Status: lint.Warn, Details: "The reason code CRL entry extension SHOULD be absent instead of using the unspecified (0) reasonCode value."}
+			return &lint.LintResult{
+				Status:   lint.Warn,
+				Details:  "The reason code CRL entry extension SHOULD be absent instead of using the unspecified (0) reasonCode value.",
+				Location: lint.AtRevokedCertificate(c.SerialNumber),
+			}
 		}
 		if code == 7 || code > 10 {
-			return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("Reason code, %v, not included in RFC 5280 section 5.3.1", code)}
+			return &lint.LintResult{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("Reason code, %v, not included in RFC 5280 section 5.3.1", code),
+				Location: lint.AtRevokedCertificate(c.SerialNumber),
+			}
 		}
 	}
 	return &lint.LintResult{Status: lint.Pass}
@@ -56,11 +56,12 @@ func labelLengthTooLong(domain string) bool {
 }
 
 func (l *DNSNameLabelLengthTooLong) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		labelTooLong := labelLengthTooLong(dns)
 		if labelTooLong {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
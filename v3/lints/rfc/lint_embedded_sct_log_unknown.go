@@ -0,0 +1,118 @@
+package rfc
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+RFC 6962: 3.2
+Each SCT embedded in a certificate is issued by a CT log identified by its
+LogID, the SHA-256 hash of the log's public key.
+
+This lint reports SCTs from logs that are in none of the CT log lists
+configured by the CTLogList of the [AppleRootStorePolicyConfig] and
+[ChromeRootProgramPolicyConfig] configurations. It only applies when at least
+one of them is configured.
+*************************************************************************/
+
+type embeddedSCTLogUnknown struct {
+	AppleRootStorePolicyConfig    lint.AppleRootStorePolicyConfig
+	ChromeRootProgramPolicyConfig lint.ChromeRootProgramPolicyConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_embedded_sct_log_unknown",
+			Description:   "Embedded SCTs should be issued by CT logs of the configured CT log lists",
+			Citation:      "RFC 6962: 3.2",
+			Source:        lint.RFC6962,
+			EffectiveDate: util.RFC6962Date,
+		},
+		Lint: NewEmbeddedSCTLogUnknown,
+	})
+}
+
+func NewEmbeddedSCTLogUnknown() lint.LintInterface {
+	return &embeddedSCTLogUnknown{}
+}
+
+func (l *embeddedSCTLogUnknown) Configure() interface{} {
+	return l
+}
+
+func (l *embeddedSCTLogUnknown) CheckApplies(c *x509.Certificate) bool {
+	return (l.AppleRootStorePolicyConfig.CTLogList != "" || l.ChromeRootProgramPolicyConfig.CTLogList != "") &&
+		len(c.SignedCertificateTimestampList) > 0 &&
+		!util.IsExtInCert(c, util.CtPoisonOID)
+}
+
+func (l *embeddedSCTLogUnknown) Execute(c *x509.Certificate) *lint.LintResult {
+	lists, err := configuredCTLogLists(l.AppleRootStorePolicyConfig, l.ChromeRootProgramPolicyConfig)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	var findings []lint.Finding
+	for i, sct := range c.SignedCertificateTimestampList {
+		if sct == nil || findCTLog(lists, sct.LogID) != nil {
+			continue
+		}
+		findings = append(findings, lint.Finding{
+			Status:   lint.Warn,
+			Details:  fmt.Sprintf("SCT %d is from unknown log %s", i, sct.LogID.Base64String()),
+			Location: lint.AtExtension(util.TimestampOID),
+		})
+	}
+	return lint.ResultFromFindings(findings)
+}
+
+// configuredCTLogLists returns the CT log lists that are configured by the
+// given root program configurations.
+func configuredCTLogLists(apple lint.AppleRootStorePolicyConfig, chrome lint.ChromeRootProgramPolicyConfig) ([]*util.CTLogList, error) {
+	var lists []*util.CTLogList
+	appleList, err := apple.LogList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Apple's CT log list: %w", err)
+	}
+	if appleList != nil {
+		lists = append(lists, appleList)
+	}
+	chromeList, err := chrome.LogList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Chrome's CT log list: %w", err)
+	}
+	if chromeList != nil {
+		lists = append(lists, chromeList)
+	}
+	return lists, nil
+}
+
+// findCTLog returns the log with the given log ID from the first of the lists
+// that has it, or nil if none of them has it.
+func findCTLog(lists []*util.CTLogList, id ct.SHA256Hash) *util.CTLog {
+	for _, list := range lists {
+		if log := list.Log(id); log != nil {
+			return log
+		}
+	}
+	return nil
+}
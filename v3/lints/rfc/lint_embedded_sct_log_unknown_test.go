@@ -0,0 +1,66 @@
+package rfc
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestEmbeddedSCTLogUnknown(t *testing.T) {
+	const config = `
+[AppleRootStorePolicyConfig]
+CTLogList = "../../testdata/sctLogList.json"
+`
+	data := []struct {
+		input    string
+		config   string
+		want     lint.LintStatus
+		findings int
+	}{
+		{
+			input: "sctEmbeddedInvalid.pem",
+			want:  lint.NA,
+		},
+		{
+			input:  "sctEmbeddedValid.pem",
+			config: config,
+			want:   lint.Pass,
+		},
+		{
+			input:    "sctEmbeddedInvalid.pem",
+			config:   config,
+			want:     lint.Warn,
+			findings: 1,
+		},
+		{
+			input:    "ct3mo2SCTs.pem",
+			config:   config,
+			want:     lint.Warn,
+			findings: 2,
+		},
+	}
+	for _, d := range data {
+		result := test.TestLintWithConfig("w_embedded_sct_log_unknown", d.input, d.config)
+		if result.Status != d.want {
+			t.Errorf("%s: expected %s got %s: %s", d.input, d.want, result.Status, result.Details)
+		}
+		if len(result.Findings) != d.findings {
+			t.Errorf("%s: expected %d findings got %d: %v", d.input, d.findings, len(result.Findings), result.Findings)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package rfc
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+RFC 6962: 3.2
+The SCTs embedded in a certificate are signed by CT logs over the
+precertificate's TBSCertificate, which is the certificate's TBSCertificate
+without the SCT list extension, along with the hash of the issuer's public key.
+
+This lint verifies the signature of each embedded SCT with the public key of
+its log, as found in the CT log lists configured by the CTLogList of the
+[AppleRootStorePolicyConfig] and [ChromeRootProgramPolicyConfig]
+configurations, and with the issuer found among the IssuerCertificates of the
+[IssuerCertificatesConfig] configuration. SCTs from unknown logs are reported
+by w_embedded_sct_log_unknown instead.
+*************************************************************************/
+
+type embeddedSCTSignatureInvalid struct {
+	AppleRootStorePolicyConfig    lint.AppleRootStorePolicyConfig
+	ChromeRootProgramPolicyConfig lint.ChromeRootProgramPolicyConfig
+	IssuerCertificatesConfig      lint.IssuerCertificatesConfig
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_embedded_sct_signature_invalid",
+			Description:   "Embedded SCTs must be validly signed by their CT log over the precertificate",
+			Citation:      "RFC 6962: 3.2",
+			Source:        lint.RFC6962,
+			EffectiveDate: util.RFC6962Date,
+			Cost:          lint.ExpensiveCost,
+		},
+		Lint: NewEmbeddedSCTSignatureInvalid,
+	})
+}
+
+func NewEmbeddedSCTSignatureInvalid() lint.LintInterface {
+	return &embeddedSCTSignatureInvalid{}
+}
+
+func (l *embeddedSCTSignatureInvalid) Configure() interface{} {
+	return l
+}
+
+func (l *embeddedSCTSignatureInvalid) CheckApplies(c *x509.Certificate) bool {
+	return l.IssuerCertificatesConfig.IssuerCertificates != "" &&
+		(l.AppleRootStorePolicyConfig.CTLogList != "" || l.ChromeRootProgramPolicyConfig.CTLogList != "") &&
+		len(c.SignedCertificateTimestampList) > 0 &&
+		!util.IsExtInCert(c, util.CtPoisonOID)
+}
+
+func (l *embeddedSCTSignatureInvalid) Execute(c *x509.Certificate) *lint.LintResult {
+	lists, err := configuredCTLogLists(l.AppleRootStorePolicyConfig, l.ChromeRootProgramPolicyConfig)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: err.Error()}
+	}
+	issuers, err := l.IssuerCertificatesConfig.Issuers()
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("failed to read the issuer certificates: %s", err)}
+	}
+	issuer := findIssuer(c, issuers)
+	if issuer == nil {
+		return &lint.LintResult{Status: lint.NA, Details: "the issuer of the certificate is not among the configured issuer certificates"}
+	}
+	var findings []lint.Finding
+	for i, sct := range c.SignedCertificateTimestampList {
+		if sct == nil {
+			continue
+		}
+		log := findCTLog(lists, sct.LogID)
+		if log == nil {
+			continue
+		}
+		if err := util.VerifyEmbeddedSCT(c, issuer, sct, log.Key); err != nil {
+			findings = append(findings, lint.Finding{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("SCT %d from log %q: %s", i, log.Description, err),
+				Location: lint.AtExtension(util.TimestampOID),
+			})
+		}
+	}
+	return lint.ResultFromFindings(findings)
+}
+
+// findIssuer returns the certificate among issuers whose subject and key
+// identifier match the issuer and authority key identifier of c, and whose
+// key verifies the signature of c.
+func findIssuer(c *x509.Certificate, issuers []*x509.Certificate) *x509.Certificate {
+	for _, issuer := range issuers {
+		if !bytes.Equal(issuer.RawSubject, c.RawIssuer) {
+			continue
+		}
+		if len(c.AuthorityKeyId) > 0 && len(issuer.SubjectKeyId) > 0 && !bytes.Equal(c.AuthorityKeyId, issuer.SubjectKeyId) {
+			continue
+		}
+		if c.CheckSignatureFrom(issuer) == nil {
+			return issuer
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package rfc
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+/************************************************************************
+RFC 6962: 3.1
+A precertificate is submitted to CT logs before the certificate is issued,
+so the timestamps of the SCTs embedded in the certificate should not be
+much later than its notBefore. SCTs issued after notBefore, plus the
+configured MaxSkew, suggest that the notBefore of the certificate was
+backdated.
+*************************************************************************/
+
+type embeddedSCTTimestampAfterNotBefore struct {
+	MaxSkew string `comment:"How much later than the notBefore of a certificate, as a Go duration such as 24h, the timestamps of its embedded SCTs may be"`
+}
+
+func init() {
+	lint.RegisterCertificateLint(&lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "w_embedded_sct_timestamp_after_not_before",
+			Description:   "Embedded SCTs should not be issued later than the notBefore of the certificate, plus an allowed skew",
+			Citation:      "RFC 6962: 3.1",
+			Source:        lint.RFC6962,
+			EffectiveDate: util.RFC6962Date,
+		},
+		Lint: NewEmbeddedSCTTimestampAfterNotBefore,
+	})
+}
+
+func NewEmbeddedSCTTimestampAfterNotBefore() lint.LintInterface {
+	return &embeddedSCTTimestampAfterNotBefore{MaxSkew: "24h"}
+}
+
+func (l *embeddedSCTTimestampAfterNotBefore) Configure() interface{} {
+	return l
+}
+
+func (l *embeddedSCTTimestampAfterNotBefore) CheckApplies(c *x509.Certificate) bool {
+	return len(c.SignedCertificateTimestampList) > 0 && !util.IsExtInCert(c, util.CtPoisonOID)
+}
+
+func (l *embeddedSCTTimestampAfterNotBefore) Execute(c *x509.Certificate) *lint.LintResult {
+	skew, err := time.ParseDuration(l.MaxSkew)
+	if err != nil {
+		return &lint.LintResult{Status: lint.Fatal, Details: fmt.Sprintf("invalid MaxSkew: %s", err)}
+	}
+	latest := c.NotBefore.Add(skew)
+	var findings []lint.Finding
+	for i, sct := range c.SignedCertificateTimestampList {
+		if sct == nil {
+			continue
+		}
+		issued := time.UnixMilli(int64(sct.Timestamp)).UTC()
+		if issued.After(latest) {
+			findings = append(findings, lint.Finding{
+				Status: lint.Warn,
+				Details: fmt.Sprintf("SCT %d from log %s was issued at %s, %s after the notBefore of the certificate",
+					i, sct.LogID.Base64String(), issued.Format(time.RFC3339), issued.Sub(c.NotBefore)),
+				Location: lint.AtExtension(util.TimestampOID),
+			})
+		}
+	}
+	return lint.ResultFromFindings(findings)
+}
@@ -0,0 +1,72 @@
+package rfc
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"testing"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+)
+
+func TestEmbeddedSCTTimestampAfterNotBefore(t *testing.T) {
+	data := []struct {
+		input    string
+		config   string
+		want     lint.LintStatus
+		findings int
+	}{
+		{
+			input: "ctNoSCTs.pem",
+			want:  lint.NA,
+		},
+		{
+			input: "sctEmbeddedValid.pem",
+			want:  lint.Pass,
+		},
+		{
+			// One SCT was issued 48 hours after notBefore.
+			input:    "sctEmbeddedInvalid.pem",
+			want:     lint.Warn,
+			findings: 1,
+		},
+		{
+			input:  "sctEmbeddedInvalid.pem",
+			config: "[w_embedded_sct_timestamp_after_not_before]\nMaxSkew = \"72h\"",
+			want:   lint.Pass,
+		},
+		{
+			// All SCTs were issued an hour after notBefore.
+			input:    "sctEmbeddedInvalid.pem",
+			config:   "[w_embedded_sct_timestamp_after_not_before]\nMaxSkew = \"30m\"",
+			want:     lint.Warn,
+			findings: 4,
+		},
+		{
+			input:  "sctEmbeddedValid.pem",
+			config: "[w_embedded_sct_timestamp_after_not_before]\nMaxSkew = \"a day\"",
+			want:   lint.Fatal,
+		},
+	}
+	for _, d := range data {
+		result := test.TestLintWithConfig("w_embedded_sct_timestamp_after_not_before", d.input, d.config)
+		if result.Status != d.want {
+			t.Errorf("%s: expected %s got %s: %s", d.input, d.want, result.Status, result.Details)
+		}
+		if len(result.Findings) != d.findings {
+			t.Errorf("%s: expected %d findings got %d: %v", d.input, d.findings, len(result.Findings), result.Findings)
+		}
+	}
+}
@@ -51,7 +51,7 @@ func (l *ExtAiaMarkedCritical) CheckApplies(cert *x509.Certificate) bool {
 
 func (l *ExtAiaMarkedCritical) Execute(cert *x509.Certificate) *lint.LintResult {
 	if util.GetExtFromCert(cert, util.AiaOID).Critical {
-		return &lint.LintResult{Status: lint.Error}
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtExtension(util.AiaOID)}
 	} else {
 		return &lint.LintResult{Status: lint.Pass}
 	}
@@ -51,7 +51,7 @@ func (l *authorityKeyIdCritical) CheckApplies(c *x509.Certificate) bool {
 func (l *authorityKeyIdCritical) Execute(c *x509.Certificate) *lint.LintResult {
 	aki := util.GetExtFromCert(c, util.AuthkeyOID) //pointer to the extension
 	if aki.Critical {
-		return &lint.LintResult{Status: lint.Error}
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtExtension(util.AuthkeyOID)}
 	} else { //implies !aki.Critical
 		return &lint.LintResult{Status: lint.Pass}
 	}
@@ -51,7 +51,7 @@ func (l *ExtFreshestCrlMarkedCritical) CheckApplies(cert *x509.Certificate) bool
 func (l *ExtFreshestCrlMarkedCritical) Execute(cert *x509.Certificate) *lint.LintResult {
 	var fCRL *pkix.Extension = util.GetExtFromCert(cert, util.FreshCRLOID)
 	if fCRL != nil && fCRL.Critical {
-		return &lint.LintResult{Status: lint.Error}
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtExtension(util.FreshCRLOID)}
 	} else if fCRL != nil && !fCRL.Critical {
 		return &lint.LintResult{Status: lint.Pass}
 	}
@@ -15,7 +15,6 @@ package rfc
  */
 
 import (
-	"github.com/zmap/zcrypto/encoding/asn1"
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -56,25 +55,21 @@ func (l *IANEmptyName) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.IssuerAlternateNameOID)
 }
 
+func (l *IANEmptyName) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *IANEmptyName) Execute(c *x509.Certificate) *lint.LintResult {
-	value := util.GetExtFromCert(c, util.IssuerAlternateNameOID).Value
-	var seq asn1.RawValue
-	if _, err := asn1.Unmarshal(value, &seq); err != nil {
-		return &lint.LintResult{Status: lint.Fatal}
-	}
-	if !seq.IsCompound || seq.Tag != 16 || seq.Class != 0 {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *IANEmptyName) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	names, err := cc.IssuerAltNames()
+	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
-
-	rest := seq.Bytes
-	for len(rest) > 0 {
-		var v asn1.RawValue
-		var err error
-		rest, err = asn1.Unmarshal(rest, &v)
-		if err != nil {
-			return &lint.LintResult{Status: lint.NA}
-		}
-		if len(v.Bytes) == 0 {
+	for _, name := range names {
+		if len(name.Bytes) == 0 {
 			return &lint.LintResult{Status: lint.Error}
 		}
 	}
@@ -60,10 +60,11 @@ func (l *IANSpace) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *IANSpace) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.IANDNSNames {
+	var findings []lint.Finding
+	for i, dns := range c.IANDNSNames {
 		if dns == " " {
-			return &lint.LintResult{Status: lint.Error}
+			findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtIssuerAltName(c, util.DNSNameTag, i)})
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
@@ -53,6 +53,6 @@ func (l *checkKeyUsageCritical) Execute(c *x509.Certificate) *lint.LintResult {
 	if keyUsage.Critical {
 		return &lint.LintResult{Status: lint.Pass}
 	} else {
-		return &lint.LintResult{Status: lint.Warn}
+		return &lint.LintResult{Status: lint.Warn, Location: lint.AtExtension(util.KeyUsageOID)}
 	}
 }
@@ -60,6 +60,6 @@ func (l *nameConstraintCrit) Execute(c *x509.Certificate) *lint.LintResult {
 	if e.Critical {
 		return &lint.LintResult{Status: lint.Pass}
 	} else {
-		return &lint.LintResult{Status: lint.Error}
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtExtension(util.NameConstOID)}
 	}
 }
@@ -59,18 +59,23 @@ func (l *SANDNSNotIA5String) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
 }
 
+func (l *SANDNSNotIA5String) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *SANDNSNotIA5String) Execute(c *x509.Certificate) *lint.LintResult {
-	ext := util.GetExtFromCert(c, util.SubjectAlternateNameOID)
-	if ext == nil {
-		return &lint.LintResult{Status: lint.Fatal}
-	}
-	ok, err := util.AllAlternateNameWithTagAreIA5(ext, util.DNSNameTag)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *SANDNSNotIA5String) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	names, err := cc.SubjectAltNames()
 	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
-	if ok {
-		return &lint.LintResult{Status: lint.Pass}
-	} else {
-		return &lint.LintResult{Status: lint.Error}
+	for _, name := range names {
+		if name.Tag == util.DNSNameTag && !util.IsIA5String(name.Bytes) {
+			return &lint.LintResult{Status: lint.Error}
+		}
 	}
+	return &lint.LintResult{Status: lint.Pass}
 }
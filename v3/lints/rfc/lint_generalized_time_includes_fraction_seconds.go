@@ -55,7 +55,11 @@ func NewGeneralizedTimeFraction() lint.LintInterface {
 }
 
 func (l *generalizedTimeFraction) CheckApplies(c *x509.Certificate) bool {
-	firstDate, secondDate := util.GetTimes(c)
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *generalizedTimeFraction) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	firstDate, secondDate := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(firstDate, secondDate)
 	date1Gen := beforeTag == 24
 	date2Gen := afterTag == 24
@@ -63,8 +67,12 @@ func (l *generalizedTimeFraction) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *generalizedTimeFraction) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *generalizedTimeFraction) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
 	r := lint.Pass
-	date1, date2 := util.GetTimes(c)
+	date1, date2 := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(date1, date2)
 	date1Gen := beforeTag == 24
 	date2Gen := afterTag == 24
@@ -54,7 +54,11 @@ func NewGeneralizedNotZulu() lint.LintInterface {
 }
 
 func (l *generalizedNotZulu) CheckApplies(c *x509.Certificate) bool {
-	firstDate, secondDate := util.GetTimes(c)
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *generalizedNotZulu) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	firstDate, secondDate := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(firstDate, secondDate)
 	date1Gen := beforeTag == 24
 	date2Gen := afterTag == 24
@@ -62,7 +66,11 @@ func (l *generalizedNotZulu) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *generalizedNotZulu) Execute(c *x509.Certificate) *lint.LintResult {
-	date1, date2 := util.GetTimes(c)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *generalizedNotZulu) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	date1, date2 := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(date1, date2)
 	date1Gen := beforeTag == 24
 	date2Gen := afterTag == 24
@@ -45,17 +45,28 @@ func (l *IDNMalformedUnicode) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
 }
 
+func (l *IDNMalformedUnicode) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *IDNMalformedUnicode) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *IDNMalformedUnicode) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	c := cc.Certificate
+	var findings []lint.Finding
+	for i, dns := range c.DNSNames {
 		labels := strings.Split(dns, ".")
 		for _, label := range labels {
 			if util.HasXNLabelPrefix(label) {
-				_, err := util.IdnaToUnicode(label)
+				_, err := cc.IdnaToUnicode(label)
 				if err != nil {
-					return &lint.LintResult{Status: lint.Error}
+					findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
+					break
 				}
 			}
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	return lint.ResultFromFindings(findings)
 }
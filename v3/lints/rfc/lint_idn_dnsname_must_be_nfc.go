@@ -46,20 +46,38 @@ func (l *IDNNotNFC) CheckApplies(c *x509.Certificate) bool {
 	return util.IsExtInCert(c, util.SubjectAlternateNameOID)
 }
 
+func (l *IDNNotNFC) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *IDNNotNFC) Execute(c *x509.Certificate) *lint.LintResult {
-	for _, dns := range c.DNSNames {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *IDNNotNFC) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	c := cc.Certificate
+	var findings []lint.Finding
+	// unparseable records a name that could not be parsed, which makes the
+	// lint NA unless another name has a finding.
+	unparseable := false
+	for i, dns := range c.DNSNames {
 		labels := strings.Split(dns, ".")
 		for _, label := range labels {
 			if util.HasXNLabelPrefix(label) {
-				unicodeLabel, err := util.IdnaToUnicode(label)
+				unicodeLabel, err := cc.IdnaToUnicode(label)
 				if err != nil {
-					return &lint.LintResult{Status: lint.NA}
+					unparseable = true
+					continue
 				}
 				if !norm.NFC.IsNormalString(unicodeLabel) {
-					return &lint.LintResult{Status: lint.Error}
+					findings = append(findings, lint.Finding{Status: lint.Error, Location: lint.AtSubjectAltName(c, util.DNSNameTag, i)})
+					break
 				}
 			}
 		}
 	}
-	return &lint.LintResult{Status: lint.Pass}
+	if len(findings) == 0 && unparseable {
+		return &lint.LintResult{Status: lint.NA}
+	}
+	return lint.ResultFromFindings(findings)
 }
@@ -44,9 +44,16 @@ func (l *IssuerDNCountryNotPrintableString) CheckApplies(c *x509.Certificate) bo
 	return len(c.Issuer.Country) > 0
 }
 
+func (l *IssuerDNCountryNotPrintableString) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *IssuerDNCountryNotPrintableString) Execute(c *x509.Certificate) *lint.LintResult {
-	rdnSequence := util.RawRDNSequence{}
-	rest, err := asn1.Unmarshal(c.RawIssuer, &rdnSequence)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *IssuerDNCountryNotPrintableString) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	rdnSequence, rest, err := cc.RawIssuer()
 	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
@@ -54,10 +61,10 @@ func (l *IssuerDNCountryNotPrintableString) Execute(c *x509.Certificate) *lint.L
 		return &lint.LintResult{Status: lint.Fatal}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
+	for i, attrTypeAndValueSet := range rdnSequence {
 		for _, attrTypeAndValue := range attrTypeAndValueSet {
 			if attrTypeAndValue.Type.Equal(util.CountryNameOID) && attrTypeAndValue.Value.Tag != asn1.TagPrintableString {
-				return &lint.LintResult{Status: lint.Error}
+				return &lint.LintResult{Status: lint.Error, Location: lint.AtIssuerRDN(i, attrTypeAndValue.Type)}
 			}
 		}
 	}
@@ -0,0 +1,69 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertSerialNumberNotDER struct{}
+
+/************************************************
+RFC 5280: 4.1
+   To calculate the signature, the data that is to be signed is encoded
+   using the ASN.1 distinguished encoding rules (DER) [X.690].
+
+X.690: 8.3.2
+   If the contents octets of an integer value encoding consist of more
+   than one octet, then the bits of the first octet and bit 8 of the
+   second octet:
+     a) shall not all be ones; and
+     b) shall not all be zero.
+************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_raw_cert_serial_number_not_der",
+			Description:   "Certificate serial numbers must be DER encoded INTEGERs using the minimum number of octets",
+			Citation:      "RFC 5280: 4.1 and X.690: 8.3.2",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewRawCertSerialNumberNotDER,
+	})
+}
+
+func NewRawCertSerialNumberNotDER() lint.RawCertificateLintInterface {
+	return &rawCertSerialNumberNotDER{}
+}
+
+func (l *rawCertSerialNumberNotDER) CheckApplies(raw *util.RawCertificate) bool {
+	return raw.SerialNumber.FullBytes != nil
+}
+
+func (l *rawCertSerialNumberNotDER) Execute(raw *util.RawCertificate) *lint.LintResult {
+	serial := raw.SerialNumber.Bytes
+	location := lint.AtField(lint.FieldSerialNumber)
+	if len(serial) == 0 {
+		return &lint.LintResult{Status: lint.Error, Details: "serialNumber has no contents octets", Location: location}
+	}
+	if len(serial) > 1 &&
+		((serial[0] == 0x00 && serial[1]&0x80 == 0) || (serial[0] == 0xff && serial[1]&0x80 == 0x80)) {
+		return &lint.LintResult{Status: lint.Error, Details: "serialNumber is not encoded using the minimum number of octets", Location: location}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
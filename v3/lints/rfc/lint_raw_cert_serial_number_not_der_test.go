@@ -0,0 +1,70 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func TestRawCertSerialNumberNotDER(t *testing.T) {
+	testCases := []struct {
+		name           string
+		serial         []byte
+		expectedStatus lint.LintStatus
+	}{
+		{
+			name:           "pass single octet",
+			serial:         []byte{0x00},
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "pass leading zero octet before high bit",
+			serial:         []byte{0x00, 0x80, 0x01},
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "error unnecessary leading zero octet",
+			serial:         []byte{0x00, 0x7f},
+			expectedStatus: lint.Error,
+		},
+		{
+			name:           "error unnecessary leading 0xff octet",
+			serial:         []byte{0xff, 0x80},
+			expectedStatus: lint.Error,
+		},
+		{
+			name:           "error empty",
+			serial:         []byte{},
+			expectedStatus: lint.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			der := rawTestCertificate(t, "ecdsaP256.pem", func(raw *util.RawCertificate) {
+				raw.SerialNumber.FullBytes = append([]byte{asn1.TagInteger, byte(len(tc.serial))}, tc.serial...)
+			})
+			result := test.TestLintRawCertificate(t, "e_raw_cert_serial_number_not_der", der, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"bytes"
+
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertSigAlgNotMatchTBSSigAlg struct{}
+
+/*******************************************************************
+RFC 5280: 4.1.1.2
+[the Certificate signatureAlgorithm] field MUST contain the same
+algorithm identifier as the signature field in the sequence
+tbsCertificate
+********************************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_raw_cert_sig_alg_not_match_tbs_sig_alg",
+			Description:   "Certificate signature field must match TBSCertificate signature field",
+			Citation:      "RFC 5280, Section 4.1.1.2",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC5280Date,
+		},
+		Lint: NewRawCertSigAlgNotMatchTBSSigAlg,
+	})
+}
+
+func NewRawCertSigAlgNotMatchTBSSigAlg() lint.RawCertificateLintInterface {
+	return &rawCertSigAlgNotMatchTBSSigAlg{}
+}
+
+func (l *rawCertSigAlgNotMatchTBSSigAlg) CheckApplies(raw *util.RawCertificate) bool {
+	return raw.SignatureAlgorithm.FullBytes != nil && raw.Signature.FullBytes != nil
+}
+
+func (l *rawCertSigAlgNotMatchTBSSigAlg) Execute(raw *util.RawCertificate) *lint.LintResult {
+	if !bytes.Equal(raw.SignatureAlgorithm.FullBytes, raw.Signature.FullBytes) {
+		return &lint.LintResult{Status: lint.Error, Location: lint.AtField(lint.FieldSignatureAlgorithm)}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,70 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertStructureInvalid struct{}
+
+/************************************************
+RFC 5280: 4.1
+   Certificate  ::=  SEQUENCE  {
+        tbsCertificate       TBSCertificate,
+        signatureAlgorithm   AlgorithmIdentifier,
+        signatureValue       BIT STRING  }
+
+   TBSCertificate  ::=  SEQUENCE  {
+        version         [0]  EXPLICIT Version DEFAULT v1,
+        serialNumber         CertificateSerialNumber,
+        signature            AlgorithmIdentifier,
+        issuer               Name,
+        validity             Validity,
+        subject              Name,
+        subjectPublicKeyInfo SubjectPublicKeyInfo,
+        issuerUniqueID  [1]  IMPLICIT UniqueIdentifier OPTIONAL,
+        subjectUniqueID [2]  IMPLICIT UniqueIdentifier OPTIONAL,
+        extensions      [3]  EXPLICIT Extensions OPTIONAL }
+************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_raw_cert_structure_invalid",
+			Description:   "Certificates must be a DER encoded Certificate SEQUENCE with the elements of RFC 5280 in order",
+			Citation:      "RFC 5280: 4.1",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.ZeroDate,
+		},
+		Lint: NewRawCertStructureInvalid,
+	})
+}
+
+func NewRawCertStructureInvalid() lint.RawCertificateLintInterface {
+	return &rawCertStructureInvalid{}
+}
+
+func (l *rawCertStructureInvalid) CheckApplies(raw *util.RawCertificate) bool {
+	return true
+}
+
+func (l *rawCertStructureInvalid) Execute(raw *util.RawCertificate) *lint.LintResult {
+	if raw.Err != nil {
+		return &lint.LintResult{Status: lint.Error, Details: raw.Err.Error()}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
@@ -0,0 +1,94 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// rawTestCertificate returns the DER encoding of the test certificate read
+// from inPath after its elements have been altered by mutate. Elements with a
+// nil FullBytes are omitted, and the Validity is re-encoded from NotBefore and
+// NotAfter.
+func rawTestCertificate(t *testing.T, inPath string, mutate func(raw *util.RawCertificate)) []byte {
+	t.Helper()
+	raw, err := util.ParseRawCertificate(test.ReadTestCert(inPath).Raw)
+	if err != nil {
+		t.Fatalf("unable to parse test certificate %s: %v", inPath, err)
+	}
+	mutate(raw)
+	sequence := func(elements ...[]byte) []byte {
+		der, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: bytes.Join(elements, nil)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+	tbs := sequence(
+		raw.Version.FullBytes,
+		raw.SerialNumber.FullBytes,
+		raw.Signature.FullBytes,
+		raw.Issuer.FullBytes,
+		sequence(raw.NotBefore.FullBytes, raw.NotAfter.FullBytes),
+		raw.Subject.FullBytes,
+		raw.SubjectPublicKeyInfo.FullBytes,
+		raw.IssuerUniqueID.FullBytes,
+		raw.SubjectUniqueID.FullBytes,
+		raw.Extensions.FullBytes,
+	)
+	return sequence(tbs, raw.SignatureAlgorithm.FullBytes, raw.SignatureValue.FullBytes)
+}
+
+func TestRawCertStructureInvalid(t *testing.T) {
+	der := test.ReadTestCert("ecdsaP256.pem").Raw
+	testCases := []struct {
+		name           string
+		der            []byte
+		expectedStatus lint.LintStatus
+	}{
+		{
+			name:           "pass well formed certificate",
+			der:            der,
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "error truncated certificate",
+			der:            der[:len(der)-1],
+			expectedStatus: lint.Error,
+		},
+		{
+			name: "error certificate without a subject",
+			der: rawTestCertificate(t, "ecdsaP256.pem", func(raw *util.RawCertificate) {
+				raw.Subject.FullBytes = nil
+			}),
+			expectedStatus: lint.Error,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := test.TestLintRawCertificate(t, "e_raw_cert_structure_invalid", tc.der, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"fmt"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertValidityTimeInvalid struct{}
+
+/*********************************************************************
+RFC 5280: 4.1.2.5
+   CAs conforming to this profile MUST always encode certificate
+   validity dates through the year 2049 as UTCTime; certificate validity
+   dates in 2050 or later MUST be encoded as GeneralizedTime.
+
+RFC 5280: 4.1.2.5.1 and 4.1.2.5.2
+   For the purposes of this profile, UTCTime values MUST be expressed in
+   Greenwich Mean Time (Zulu) and MUST include seconds (i.e., times are
+   YYMMDDHHMMSSZ), even where the number of seconds is zero.
+   [...] GeneralizedTime values MUST be expressed in Greenwich Mean Time
+   (Zulu) and MUST include seconds (i.e., times are YYYYMMDDHHMMSSZ),
+   even where the number of seconds is zero.  GeneralizedTime values
+   MUST NOT include fractional seconds.
+*********************************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_raw_cert_validity_time_invalid",
+			Description:   "Certificate validity times must be YYMMDDHHMMSSZ UTCTimes through 2049 and YYYYMMDDHHMMSSZ GeneralizedTimes from 2050",
+			Citation:      "RFC 5280: 4.1.2.5",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC2459Date,
+		},
+		Lint: NewRawCertValidityTimeInvalid,
+	})
+}
+
+func NewRawCertValidityTimeInvalid() lint.RawCertificateLintInterface {
+	return &rawCertValidityTimeInvalid{}
+}
+
+func (l *rawCertValidityTimeInvalid) CheckApplies(raw *util.RawCertificate) bool {
+	return raw.NotAfter.FullBytes != nil
+}
+
+func (l *rawCertValidityTimeInvalid) Execute(raw *util.RawCertificate) *lint.LintResult {
+	var findings []lint.Finding
+	for _, validity := range []struct {
+		name string
+		time asn1.RawValue
+	}{
+		{"notBefore", raw.NotBefore},
+		{"notAfter", raw.NotAfter},
+	} {
+		t, err := util.ParseRawTime(validity.time)
+		if err != nil {
+			findings = append(findings, lint.Finding{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("%s: %s", validity.name, err),
+				Location: lint.AtField(lint.FieldValidity),
+			})
+		} else if validity.time.Tag == asn1.TagGeneralizedTime && t.Before(util.GeneralizedDate) {
+			findings = append(findings, lint.Finding{
+				Status:   lint.Error,
+				Details:  fmt.Sprintf("%s: %s is before 2050 but is encoded as a GeneralizedTime", validity.name, validity.time.Bytes),
+				Location: lint.AtField(lint.FieldValidity),
+			})
+		}
+	}
+	return lint.ResultFromFindings(findings)
+}
@@ -0,0 +1,78 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func TestRawCertValidityTimeInvalid(t *testing.T) {
+	encode := func(tag int, value string) []byte {
+		der, err := asn1.Marshal(asn1.RawValue{Tag: tag, Bytes: []byte(value)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+	testCases := []struct {
+		name             string
+		notBefore        []byte
+		notAfter         []byte
+		expectedStatus   lint.LintStatus
+		expectedFindings int
+	}{
+		{
+			name:           "pass UTCTime and GeneralizedTime",
+			notBefore:      encode(asn1.TagUTCTime, "240101000000Z"),
+			notAfter:       encode(asn1.TagGeneralizedTime, "20500101000000Z"),
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:             "error UTCTime without seconds",
+			notBefore:        encode(asn1.TagUTCTime, "2401010000Z"),
+			notAfter:         encode(asn1.TagUTCTime, "250101000000Z"),
+			expectedStatus:   lint.Error,
+			expectedFindings: 1,
+		},
+		{
+			name:             "error GeneralizedTimes before 2050",
+			notBefore:        encode(asn1.TagGeneralizedTime, "20240101000000Z"),
+			notAfter:         encode(asn1.TagGeneralizedTime, "20250101000000Z"),
+			expectedStatus:   lint.Error,
+			expectedFindings: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			der := rawTestCertificate(t, "ecdsaP256.pem", func(raw *util.RawCertificate) {
+				raw.NotBefore.FullBytes = tc.notBefore
+				raw.NotAfter.FullBytes = tc.notAfter
+			})
+			result := test.TestLintRawCertificate(t, "e_raw_cert_validity_time_invalid", der, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+			if len(result.Findings) != tc.expectedFindings {
+				t.Errorf("expected %d findings, got %d", tc.expectedFindings, len(result.Findings))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+type rawCertVersionInvalid struct{}
+
+/************************************************
+RFC 5280: 4.1.2.1
+   This field describes the version of the encoded certificate.  When
+   extensions are used, as expected in this profile, version MUST be 3
+   (value is 2).  If no extensions are present, but a UniqueIdentifier
+   is present, the version SHOULD be 2 (value is 1); however, the
+   version MAY be 3.  If only basic fields are present, the version
+   SHOULD be 1 (the value is omitted from the certificate as the
+   default value); however, the version MAY be 2 or 3.
+
+X.690: 11.5
+   The encoding of a set value or sequence value shall not include an
+   encoding for any component value which is equal to its default value.
+************************************************/
+
+func init() {
+	lint.RegisterRawCertificateLint(&lint.RawCertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:          "e_raw_cert_version_invalid",
+			Description:   "Certificate versions must be v1 (omitted), v2 or v3 and must be v3 when extensions are present",
+			Citation:      "RFC 5280: 4.1.2.1",
+			Source:        lint.RFC5280,
+			EffectiveDate: util.RFC5280Date,
+		},
+		Lint: NewRawCertVersionInvalid,
+	})
+}
+
+func NewRawCertVersionInvalid() lint.RawCertificateLintInterface {
+	return &rawCertVersionInvalid{}
+}
+
+// CheckApplies returns true if the TBSCertificate could be read as far as its
+// serialNumber, so that whether a version is present is known.
+func (l *rawCertVersionInvalid) CheckApplies(raw *util.RawCertificate) bool {
+	return raw.SerialNumber.FullBytes != nil
+}
+
+func (l *rawCertVersionInvalid) Execute(raw *util.RawCertificate) *lint.LintResult {
+	location := lint.AtField(lint.FieldVersion)
+	if raw.Version.FullBytes == nil {
+		if raw.Extensions.FullBytes != nil {
+			return &lint.LintResult{Status: lint.Error, Details: "version is omitted, and so is v1, but extensions are present", Location: location}
+		}
+		return &lint.LintResult{Status: lint.Pass}
+	}
+
+	var version *big.Int
+	rest, err := asn1.Unmarshal(raw.Version.Bytes, &version)
+	if err != nil || len(rest) != 0 {
+		return &lint.LintResult{Status: lint.Error, Details: "version is not a single DER encoded INTEGER", Location: location}
+	}
+	switch {
+	case version.Sign() == 0:
+		return &lint.LintResult{Status: lint.Error, Details: "version v1 must be omitted as it is the DEFAULT", Location: location}
+	case version.Cmp(big.NewInt(2)) > 0 || version.Sign() < 0:
+		return &lint.LintResult{Status: lint.Error, Details: fmt.Sprintf("version value %s is not v1, v2 or v3", version), Location: location}
+	case raw.Extensions.FullBytes != nil && version.Int64() != 2:
+		return &lint.LintResult{Status: lint.Error, Details: "version is v2, but extensions are present", Location: location}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
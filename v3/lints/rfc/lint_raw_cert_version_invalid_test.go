@@ -0,0 +1,83 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package rfc
+
+import (
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/test"
+	"github.com/zmap/zlint/v3/util"
+)
+
+func TestRawCertVersionInvalid(t *testing.T) {
+	version := func(value byte) func(raw *util.RawCertificate) {
+		return func(raw *util.RawCertificate) {
+			raw.Version.FullBytes = []byte{0xa0, 3, asn1.TagInteger, 1, value}
+		}
+	}
+	testCases := []struct {
+		name           string
+		mutate         func(raw *util.RawCertificate)
+		expectedStatus lint.LintStatus
+	}{
+		{
+			name:           "pass v3 certificate with extensions",
+			mutate:         func(raw *util.RawCertificate) {},
+			expectedStatus: lint.Pass,
+		},
+		{
+			name:           "error explicitly encoded v1",
+			mutate:         version(0),
+			expectedStatus: lint.Error,
+		},
+		{
+			name:           "error v2 certificate with extensions",
+			mutate:         version(1),
+			expectedStatus: lint.Error,
+		},
+		{
+			name:           "error unknown version",
+			mutate:         version(3),
+			expectedStatus: lint.Error,
+		},
+		{
+			name: "error omitted version with extensions",
+			mutate: func(raw *util.RawCertificate) {
+				raw.Version.FullBytes = nil
+			},
+			expectedStatus: lint.Error,
+		},
+		{
+			name: "pass omitted version without extensions",
+			mutate: func(raw *util.RawCertificate) {
+				raw.Version.FullBytes = nil
+				raw.Extensions.FullBytes = nil
+			},
+			expectedStatus: lint.Pass,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			der := rawTestCertificate(t, "ecdsaP256.pem", tc.mutate)
+			result := test.TestLintRawCertificate(t, "e_raw_cert_version_invalid", der, lint.NewEmptyConfig())
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected result %v was %v (%s)", tc.expectedStatus, result.Status, result.Details)
+			}
+		})
+	}
+}
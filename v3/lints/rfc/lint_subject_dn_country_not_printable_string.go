@@ -44,9 +44,16 @@ func (l *SubjectDNCountryNotPrintableString) CheckApplies(c *x509.Certificate) b
 	return len(c.Subject.Country) > 0
 }
 
+func (l *SubjectDNCountryNotPrintableString) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *SubjectDNCountryNotPrintableString) Execute(c *x509.Certificate) *lint.LintResult {
-	rdnSequence := util.RawRDNSequence{}
-	rest, err := asn1.Unmarshal(c.RawSubject, &rdnSequence)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *SubjectDNCountryNotPrintableString) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	rdnSequence, rest, err := cc.RawSubject()
 	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
@@ -54,10 +61,10 @@ func (l *SubjectDNCountryNotPrintableString) Execute(c *x509.Certificate) *lint.
 		return &lint.LintResult{Status: lint.Fatal}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
+	for i, attrTypeAndValueSet := range rdnSequence {
 		for _, attrTypeAndValue := range attrTypeAndValueSet {
 			if attrTypeAndValue.Type.Equal(util.CountryNameOID) && attrTypeAndValue.Value.Tag != asn1.TagPrintableString {
-				return &lint.LintResult{Status: lint.Error}
+				return &lint.LintResult{Status: lint.Error, Location: lint.AtSubjectRDN(i, attrTypeAndValue.Type)}
 			}
 		}
 	}
@@ -17,7 +17,6 @@ package rfc
 import (
 	"unicode/utf8"
 
-	"github.com/zmap/zcrypto/encoding/asn1"
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
 	"github.com/zmap/zlint/v3/util"
@@ -46,9 +45,16 @@ func (l *subjectDNNotPrintableCharacters) CheckApplies(c *x509.Certificate) bool
 	return true
 }
 
+func (l *subjectDNNotPrintableCharacters) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *subjectDNNotPrintableCharacters) Execute(c *x509.Certificate) *lint.LintResult {
-	rdnSequence := util.RawRDNSequence{}
-	rest, err := asn1.Unmarshal(c.RawSubject, &rdnSequence)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *subjectDNNotPrintableCharacters) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	rdnSequence, rest, err := cc.RawSubject()
 	if err != nil {
 		return &lint.LintResult{Status: lint.Fatal}
 	}
@@ -56,16 +62,16 @@ func (l *subjectDNNotPrintableCharacters) Execute(c *x509.Certificate) *lint.Lin
 		return &lint.LintResult{Status: lint.Fatal}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
+	for i, attrTypeAndValueSet := range rdnSequence {
 		for _, attrTypeAndValue := range attrTypeAndValueSet {
 			bytes := attrTypeAndValue.Value.Bytes
 			for len(bytes) > 0 {
 				r, size := utf8.DecodeRune(bytes)
 				if r < 0x20 {
-					return &lint.LintResult{Status: lint.Error}
+					return &lint.LintResult{Status: lint.Error, Location: lint.AtSubjectRDN(i, attrTypeAndValue.Type)}
 				}
 				if r >= 0x7F && r <= 0x9F {
-					return &lint.LintResult{Status: lint.Error}
+					return &lint.LintResult{Status: lint.Error, Location: lint.AtSubjectRDN(i, attrTypeAndValue.Type)}
 				}
 				bytes = bytes[size:]
 			}
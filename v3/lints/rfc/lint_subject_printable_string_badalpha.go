@@ -68,14 +68,21 @@ func (l *subjectPrintableStringBadAlpha) CheckApplies(c *x509.Certificate) bool
 	return len(c.RawSubject) > 0
 }
 
+func (l *subjectPrintableStringBadAlpha) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 // Execute checks the certificate's RawSubject to ensure that any
 // PrintableString attribute/value pairs in the Subject match the character set
 // defined for this type in RFC 5280. An lint.Error level lint.LintResult is returned if any
 // of the PrintableString attributes do not match a regular expression for the
 // allowed character set.
 func (l *subjectPrintableStringBadAlpha) Execute(c *x509.Certificate) *lint.LintResult {
-	rdnSequence := util.RawRDNSequence{}
-	rest, err := asn1.Unmarshal(c.RawSubject, &rdnSequence)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *subjectPrintableStringBadAlpha) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	rdnSequence, rest, err := cc.RawSubject()
 	if err != nil {
 		return &lint.LintResult{
 			Status:  lint.Fatal,
@@ -89,7 +96,7 @@ func (l *subjectPrintableStringBadAlpha) Execute(c *x509.Certificate) *lint.Lint
 		}
 	}
 
-	for _, attrTypeAndValueSet := range rdnSequence {
+	for i, attrTypeAndValueSet := range rdnSequence {
 		for _, attrTypeAndValue := range attrTypeAndValueSet {
 			// If the attribute type is a PrintableString the bytes of the attribute
 			// value must match the printable string alphabet.
@@ -99,6 +106,7 @@ func (l *subjectPrintableStringBadAlpha) Execute(c *x509.Certificate) *lint.Lint
 						Status: lint.Error,
 						Details: fmt.Sprintf("RawSubject attr oid %s %s",
 							attrTypeAndValue.Type, err.Error()),
+						Location: lint.AtSubjectRDN(i, attrTypeAndValue.Type),
 					}
 				}
 			}
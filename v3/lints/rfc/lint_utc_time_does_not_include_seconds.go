@@ -58,7 +58,11 @@ func NewUtcNoSecond() lint.LintInterface {
 }
 
 func (l *utcNoSecond) CheckApplies(c *x509.Certificate) bool {
-	firstDate, secondDate := util.GetTimes(c)
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *utcNoSecond) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	firstDate, secondDate := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(firstDate, secondDate)
 	date1Utc := beforeTag == 23
 	date2Utc := afterTag == 23
@@ -66,7 +70,11 @@ func (l *utcNoSecond) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *utcNoSecond) Execute(c *x509.Certificate) *lint.LintResult {
-	date1, date2 := util.GetTimes(c)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *utcNoSecond) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	date1, date2 := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(date1, date2)
 	date1Utc := beforeTag == 23
 	date2Utc := afterTag == 23
@@ -61,7 +61,11 @@ func NewUtcTimeGMT() lint.LintInterface {
 }
 
 func (l *utcTimeGMT) CheckApplies(c *x509.Certificate) bool {
-	firstDate, secondDate := util.GetTimes(c)
+	return l.CheckAppliesWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *utcTimeGMT) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	firstDate, secondDate := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(firstDate, secondDate)
 	date1Utc := beforeTag == 23
 	date2Utc := afterTag == 23
@@ -69,8 +73,13 @@ func (l *utcTimeGMT) CheckApplies(c *x509.Certificate) bool {
 }
 
 func (l *utcTimeGMT) Execute(c *x509.Certificate) *lint.LintResult {
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *utcTimeGMT) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	c := cc.Certificate
 	var r lint.LintStatus
-	firstDate, secondDate := util.GetTimes(c)
+	firstDate, secondDate := cc.Times()
 	beforeTag, afterTag := util.FindTimeType(firstDate, secondDate)
 	date1Utc := beforeTag == 23
 	date2Utc := afterTag == 23
@@ -54,8 +54,16 @@ func (l *generalizedPre2050) CheckApplies(c *x509.Certificate) bool {
 	return true
 }
 
+func (l *generalizedPre2050) CheckAppliesWithContext(cc *lint.CertificateContext) bool {
+	return l.CheckApplies(cc.Certificate)
+}
+
 func (l *generalizedPre2050) Execute(c *x509.Certificate) *lint.LintResult {
-	date1, date2 := util.GetTimes(c)
+	return l.ExecuteWithContext(lint.NewCertificateContext(c))
+}
+
+func (l *generalizedPre2050) ExecuteWithContext(cc *lint.CertificateContext) *lint.LintResult {
+	date1, date2 := cc.Times()
 	var t time.Time
 	type1, type2 := util.FindTimeType(date1, date2)
 	if type1 == 24 {
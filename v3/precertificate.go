@@ -0,0 +1,244 @@
+package zlint
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/pkix"
+	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
+)
+
+// PrecertificateDifference is a difference between the TBSCertificate of
+// a precertificate and that of the certificate issued from it.
+type PrecertificateDifference struct {
+	Location *lint.Location `json:"location,omitempty"`
+	Details  string         `json:"details"`
+}
+
+func (d PrecertificateDifference) String() string {
+	return fmt.Sprintf("%s: %s", d.Location, d.Details)
+}
+
+// ComparePrecertificate reports every difference between the TBSCertificate
+// of precert and that of cert, the certificate issued from it, other than
+// those allowed by RFC 6962, section 3.1: the poison extension of the
+// precertificate and the SCT list extension of the certificate. No differences
+// are reported if cert matches precert.
+//
+// If precert was issued by a precertificate signing certificate then that
+// certificate must be given as precertIssuer, so that the issuer and authority
+// key identifier of precert are expected to be those of the precertificate
+// signing certificate's issuer. Otherwise precertIssuer may be nil.
+func ComparePrecertificate(precert, cert, precertIssuer *x509.Certificate) ([]PrecertificateDifference, error) {
+	if precert == nil || cert == nil {
+		return nil, errors.New("a precertificate and a certificate are required")
+	}
+	if !util.IsExtInCert(precert, util.CtPoisonOID) {
+		return nil, errors.New("the precertificate does not have the CT poison extension")
+	}
+	expectedIssuer := precert.RawIssuer
+	viaSigningCertificate := false
+	if precertIssuer != nil {
+		if !bytes.Equal(precert.RawIssuer, precertIssuer.RawSubject) {
+			return nil, errors.New("the precertificate was not issued by the given precertificate issuer")
+		}
+		if isPrecertificateSigningCertificate(precertIssuer) {
+			expectedIssuer = precertIssuer.RawIssuer
+			viaSigningCertificate = true
+		}
+	}
+
+	var diffs []PrecertificateDifference
+	add := func(location *lint.Location, format string, args ...interface{}) {
+		diffs = append(diffs, PrecertificateDifference{Location: location, Details: fmt.Sprintf(format, args...)})
+	}
+
+	if precert.Version != cert.Version {
+		add(lint.AtField(lint.FieldVersion), "version changed from %d to %d", precert.Version, cert.Version)
+	}
+	if precert.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+		add(lint.AtField(lint.FieldSerialNumber), "serial number changed from %#x to %#x", precert.SerialNumber, cert.SerialNumber)
+	}
+	precertSigAlg, _ := util.GetSignatureAlgorithmInTBSEncoded(precert)
+	certSigAlg, _ := util.GetSignatureAlgorithmInTBSEncoded(cert)
+	if !bytes.Equal(precertSigAlg, certSigAlg) {
+		add(lint.AtField(lint.FieldSignature), "signature algorithm changed from %s to %s", precert.SignatureAlgorithmOID, cert.SignatureAlgorithmOID)
+	}
+	if !bytes.Equal(expectedIssuer, cert.RawIssuer) {
+		add(lint.AtField(lint.FieldIssuer), "issuer changed from %q to %q", rdnString(expectedIssuer), rdnString(cert.RawIssuer))
+	}
+	precertNotBefore, precertNotAfter := util.GetTimes(precert)
+	certNotBefore, certNotAfter := util.GetTimes(cert)
+	if !bytes.Equal(precertNotBefore.FullBytes, certNotBefore.FullBytes) {
+		add(lint.AtField(lint.FieldValidity), "notBefore changed from %s to %s", precert.NotBefore, cert.NotBefore)
+	}
+	if !bytes.Equal(precertNotAfter.FullBytes, certNotAfter.FullBytes) {
+		add(lint.AtField(lint.FieldValidity), "notAfter changed from %s to %s", precert.NotAfter, cert.NotAfter)
+	}
+	if !bytes.Equal(precert.RawSubject, cert.RawSubject) {
+		add(lint.AtField(lint.FieldSubject), "subject changed from %q to %q", rdnString(precert.RawSubject), rdnString(cert.RawSubject))
+	}
+	if !bytes.Equal(precert.RawSubjectPublicKeyInfo, cert.RawSubjectPublicKeyInfo) {
+		add(lint.AtField(lint.FieldSubjectPublicKeyInfo), "subject public key changed")
+	}
+	if !bytes.Equal(precert.IssuerUniqueId.Bytes, cert.IssuerUniqueId.Bytes) {
+		add(lint.AtField(lint.FieldIssuer), "issuerUniqueID changed")
+	}
+	if !bytes.Equal(precert.SubjectUniqueId.Bytes, cert.SubjectUniqueId.Bytes) {
+		add(lint.AtField(lint.FieldSubject), "subjectUniqueID changed")
+	}
+
+	if util.IsExtInCert(precert, util.TimestampOID) {
+		add(lint.AtExtension(util.TimestampOID), "the precertificate has an SCT list extension")
+	}
+	if util.IsExtInCert(cert, util.CtPoisonOID) {
+		add(lint.AtExtension(util.CtPoisonOID), "the certificate has the CT poison extension")
+	}
+	precertExts := extensionsWithout(precert.Extensions, util.CtPoisonOID, util.TimestampOID)
+	certExts := extensionsWithout(cert.Extensions, util.CtPoisonOID, util.TimestampOID)
+	certExtsByOID := make(map[string]pkix.Extension)
+	for _, ext := range certExts {
+		certExtsByOID[ext.Id.String()] = ext
+	}
+	precertExtsByOID := make(map[string]pkix.Extension)
+	var precertOrder, certOrder []string
+	for _, ext := range precertExts {
+		oid := ext.Id.String()
+		precertExtsByOID[oid] = ext
+		certExt, ok := certExtsByOID[oid]
+		if !ok {
+			add(lint.AtExtension(ext.Id), "extension %s removed", oid)
+			continue
+		}
+		precertOrder = append(precertOrder, oid)
+		if ext.Critical != certExt.Critical {
+			add(lint.AtExtension(ext.Id), "extension %s criticality changed from %t to %t", oid, ext.Critical, certExt.Critical)
+		}
+		if viaSigningCertificate && ext.Id.Equal(util.AuthkeyOID) {
+			// The authority key identifier of the precertificate identifies
+			// the precertificate signing certificate, whose own authority key
+			// identifier is expected in the certificate instead.
+			if !bytes.Equal(precertIssuer.AuthorityKeyId, cert.AuthorityKeyId) {
+				add(lint.AtExtension(ext.Id), "authority key identifier changed from %x to %x", precertIssuer.AuthorityKeyId, cert.AuthorityKeyId)
+			}
+			continue
+		}
+		if bytes.Equal(ext.Value, certExt.Value) {
+			continue
+		}
+		if ext.Id.Equal(util.SubjectAlternateNameOID) {
+			add(lint.AtExtension(ext.Id), "subject alternative names changed: %s", namesDifference(precert, cert))
+		} else {
+			add(lint.AtExtension(ext.Id), "extension %s value changed", oid)
+		}
+	}
+	for _, ext := range certExts {
+		oid := ext.Id.String()
+		if _, ok := precertExtsByOID[oid]; !ok {
+			add(lint.AtExtension(ext.Id), "extension %s added", oid)
+			continue
+		}
+		certOrder = append(certOrder, oid)
+	}
+	if strings.Join(precertOrder, ",") != strings.Join(certOrder, ",") {
+		add(lint.AtField(lint.FieldExtensions), "extensions reordered from [%s] to [%s]",
+			strings.Join(precertOrder, ", "), strings.Join(certOrder, ", "))
+	}
+	return diffs, nil
+}
+
+func isPrecertificateSigningCertificate(c *x509.Certificate) bool {
+	for _, eku := range c.UnknownExtKeyUsage {
+		if eku.Equal(util.PreCertificateSigningCertificateEKU) {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionsWithout returns the extensions other than those identified by
+// oids.
+func extensionsWithout(extensions []pkix.Extension, oids ...asn1.ObjectIdentifier) []pkix.Extension {
+	var kept []pkix.Extension
+next:
+	for _, ext := range extensions {
+		for _, oid := range oids {
+			if ext.Id.Equal(oid) {
+				continue next
+			}
+		}
+		kept = append(kept, ext)
+	}
+	return kept
+}
+
+// rdnString returns the string representation of the DER encoded name.
+func rdnString(der []byte) string {
+	var rdns pkix.RDNSequence
+	if _, err := asn1.Unmarshal(der, &rdns); err != nil {
+		return fmt.Sprintf("%x", der)
+	}
+	var name pkix.Name
+	name.FillFromRDNSequence(&rdns)
+	return name.String()
+}
+
+// namesDifference describes the subject alternative names that were added
+// and removed between the precertificate and the certificate.
+func namesDifference(precert, cert *x509.Certificate) string {
+	before, after := altNames(precert), altNames(cert)
+	var added, removed []string
+	for name := range after {
+		if !before[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range before {
+		if !after[name] {
+			removed = append(removed, name)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return "same names, different encoding"
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return fmt.Sprintf("added [%s], removed [%s]", strings.Join(added, ", "), strings.Join(removed, ", "))
+}
+
+func altNames(c *x509.Certificate) map[string]bool {
+	names := make(map[string]bool)
+	for _, name := range c.DNSNames {
+		names["DNS:"+name] = true
+	}
+	for _, ip := range c.IPAddresses {
+		names["IP:"+ip.String()] = true
+	}
+	for _, email := range c.EmailAddresses {
+		names["email:"+email] = true
+	}
+	for _, uri := range c.URIs {
+		names["URI:"+uri] = true
+	}
+	return names
+}
@@ -0,0 +1,119 @@
+package zlint
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/pem"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func readTestCertificate(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("no PEM block in %s", name)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestComparePrecertificate(t *testing.T) {
+	precert := readTestCertificate(t, "precertPairPrecert.pem")
+	cert := readTestCertificate(t, "precertPairCert.pem")
+	signedPrecert := readTestCertificate(t, "precertPairSignedPrecert.pem")
+	signingCert := readTestCertificate(t, "precertPairSigningCertificate.pem")
+
+	testCases := []struct {
+		name          string
+		precert       *x509.Certificate
+		cert          *x509.Certificate
+		precertIssuer *x509.Certificate
+		want          []string
+	}{
+		{
+			name:    "matching",
+			precert: precert,
+			cert:    cert,
+		},
+		{
+			name:    "diverged",
+			precert: precert,
+			cert:    readTestCertificate(t, "precertPairDivergedCert.pem"),
+			want: []string{
+				"serialNumber: serial number changed from 0x64 to 0x65",
+				"validity: notAfter changed from 2025-05-30 00:00:00 +0000 UTC to 2025-05-31 00:00:00 +0000 UTC",
+				"extensions[2.5.29.17]: subject alternative names changed: added [DNS:extra.example.com], removed []",
+				"extensions[1.3.6.1.4.1.44947.1.2]: extension 1.3.6.1.4.1.44947.1.2 criticality changed from false to true",
+				"extensions[1.3.6.1.4.1.44947.1.3]: extension 1.3.6.1.4.1.44947.1.3 removed",
+				"extensions[1.3.6.1.4.1.44947.1.4]: extension 1.3.6.1.4.1.44947.1.4 added",
+				"extensions: extensions reordered from [2.5.29.15, 2.5.29.37, 2.5.29.35, 2.5.29.17, 1.3.6.1.4.1.44947.1.1, 1.3.6.1.4.1.44947.1.2] " +
+					"to [2.5.29.15, 2.5.29.37, 2.5.29.35, 2.5.29.17, 1.3.6.1.4.1.44947.1.2, 1.3.6.1.4.1.44947.1.1]",
+			},
+		},
+		{
+			name:          "precertificate signing certificate",
+			precert:       signedPrecert,
+			cert:          cert,
+			precertIssuer: signingCert,
+		},
+		{
+			name:    "precertificate signing certificate not given",
+			precert: signedPrecert,
+			cert:    cert,
+			want: []string{
+				`issuer: issuer changed from "CN=Precert Test CA Precertificate Signing, O=ZLint" to "CN=Precert Test CA, O=ZLint"`,
+				"extensions[2.5.29.35]: extension 2.5.29.35 value changed",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diffs, err := ComparePrecertificate(tc.precert, tc.cert, tc.precertIssuer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, diff := range diffs {
+				got = append(got, diff.String())
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected differences\n%q\ngot\n%q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestComparePrecertificateErrors(t *testing.T) {
+	precert := readTestCertificate(t, "precertPairPrecert.pem")
+	cert := readTestCertificate(t, "precertPairCert.pem")
+	signingCert := readTestCertificate(t, "precertPairSigningCertificate.pem")
+	if _, err := ComparePrecertificate(cert, cert, nil); err == nil {
+		t.Error("expected an error for a precertificate without the poison extension")
+	}
+	if _, err := ComparePrecertificate(precert, cert, signingCert); err == nil {
+		t.Error("expected an error for a precertificate not issued by the precertificate issuer")
+	}
+}
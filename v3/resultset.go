@@ -15,8 +15,12 @@
 package zlint
 
 import (
+	"sort"
+	"time"
+
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
 	"golang.org/x/crypto/ocsp"
 )
 
@@ -30,48 +34,121 @@ type ResultSet struct {
 	WarningsPresent bool                        `json:"warnings_present"`
 	ErrorsPresent   bool                        `json:"errors_present"`
 	FatalsPresent   bool                        `json:"fatals_present"`
+	// StoppedEarly is set if linting stopped before every lint was executed
+	// because of the StopAt Option.
+	StoppedEarly bool `json:"stopped_early,omitempty"`
 }
 
 // Execute lints on the given certificate with all of the lints in the provided
 // registry. The ResultSet is mutated to trace the lint results obtained from
 // linting the certificate.
-func (z *ResultSet) executeCertificate(o *x509.Certificate, registry lint.Registry) {
+func (z *ResultSet) executeCertificate(o *x509.Certificate, registry lint.Registry, opts options) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	// Every lint shares the views of the certificate cached by cc.
+	cc := lint.NewCertificateContext(o)
 	// Run each lint from the registry.
-	for _, lint := range registry.CertificateLints().Lints() {
-		res := lint.Execute(o, registry.GetConfiguration())
-		res.LintMetadata = lint.LintMetadata
-		z.Results[lint.Name] = res
-		z.updateErrorStatePresent(res)
-	}
+	executeLints(z, registry.CertificateLints().Lints(), lint.CertificateInput, opts,
+		func(l *lint.CertificateLint) lint.LintMetadata { return l.LintMetadata },
+		func(l *lint.CertificateLint) *lint.LintResult {
+			return l.ExecuteWithContext(cc, registry.GetConfiguration())
+		})
 }
 
 // Execute lints on the given CRL with all of the lints in the provided
 // registry. The ResultSet is mutated to trace the lint results obtained from
 // linting the CRL.
-func (z *ResultSet) executeRevocationList(o *x509.RevocationList, registry lint.Registry) {
+func (z *ResultSet) executeRevocationList(o *x509.RevocationList, registry lint.Registry, opts options) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
 	// Run each lints from the registry.
-	for _, lint := range registry.RevocationListLints().Lints() {
-		res := lint.Execute(o, registry.GetConfiguration())
-		res.LintMetadata = lint.LintMetadata
-		z.Results[lint.Name] = res
-		z.updateErrorStatePresent(res)
-	}
+	executeLints(z, registry.RevocationListLints().Lints(), lint.RevocationListInput, opts,
+		func(l *lint.RevocationListLint) lint.LintMetadata { return l.LintMetadata },
+		func(l *lint.RevocationListLint) *lint.LintResult {
+			return l.Execute(o, registry.GetConfiguration())
+		})
 }
 
 // Execute lints on the given OCSP response with all of the lints in the provided
 // registry. The ResultSet is mutated to trace the lint results obtained from
 // linting the OCSP response.
-func (z *ResultSet) executeOcspResponse(o *ocsp.Response, registry lint.Registry) {
+func (z *ResultSet) executeOcspResponse(o *ocsp.Response, registry lint.Registry, opts options) {
 	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
 	// Run each lints from the registry.
-	for _, lint := range registry.OcspResponseLints().Lints() {
-		res := lint.Execute(o, registry.GetConfiguration())
-		res.LintMetadata = lint.LintMetadata
-		z.Results[lint.Name] = res
+	executeLints(z, registry.OcspResponseLints().Lints(), lint.OcspResponseInput, opts,
+		func(l *lint.OcspResponseLint) lint.LintMetadata { return l.LintMetadata },
+		func(l *lint.OcspResponseLint) *lint.LintResult {
+			return l.Execute(o, registry.GetConfiguration())
+		})
+}
+
+// Execute lints on the given DER encoded certificate with all of the raw
+// certificate lints in the provided registry. The ResultSet is mutated to trace
+// the lint results obtained from linting the certificate.
+func (z *ResultSet) executeRawCertificate(der []byte, registry lint.Registry, opts options) {
+	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	// Every lint shares the elements of the certificate split out by raw.
+	raw, _ := util.ParseRawCertificate(der)
+	// Run each lints from the registry.
+	executeLints(z, lint.RawCertificateLintsOf(registry), lint.RawCertificateInput, opts,
+		func(l *lint.RawCertificateLint) lint.LintMetadata { return l.LintMetadata },
+		func(l *lint.RawCertificateLint) *lint.LintResult {
+			return l.Execute(raw, registry.GetConfiguration())
+		})
+}
+
+// Execute lints on the given certificate signing request with all of the lints
+// in the provided registry. The ResultSet is mutated to trace the lint results
+// obtained from linting the request.
+func (z *ResultSet) executeCertificateRequest(r *x509.CertificateRequest, registry lint.Registry, opts options) {
+	z.Results = make(map[string]*lint.LintResult, len(registry.Names()))
+	// Run each lints from the registry.
+	executeLints(z, lint.CertificateRequestLintsOf(registry), lint.CertificateRequestInput, opts,
+		func(l *lint.CertificateRequestLint) lint.LintMetadata { return l.LintMetadata },
+		func(l *lint.CertificateRequestLint) *lint.LintResult {
+			return l.Execute(r, registry.GetConfiguration())
+		})
+}
+
+// executeLints executes each of lints against an input of the given type with
+// execute, recording the results in z. The lints are executed in order of
+// their cost if opts.cheapFirst is set, each is observed by opts.observers, and
+// execution stops once opts.stop is satisfied.
+func executeLints[L any](z *ResultSet, lints []L, input lint.InputType, opts options, metadata func(L) lint.LintMetadata, execute func(L) *lint.LintResult) {
+	if opts.cheapFirst {
+		lints = append([]L(nil), lints...)
+		sort.SliceStable(lints, func(i, j int) bool {
+			return metadata(lints[i]).Cost < metadata(lints[j]).Cost
+		})
+	}
+	for _, l := range lints {
+		meta := metadata(l)
+		res := observe(opts.observers, meta, input, func() *lint.LintResult {
+			return execute(l)
+		})
+		res.LintMetadata = meta
+		z.Results[meta.Name] = res
 		z.updateErrorStatePresent(res)
+		if opts.stop(res) {
+			z.StoppedEarly = true
+			break
+		}
+	}
+}
+
+// observe runs execute, notifying each of the observers before and after.
+func observe(observers []lint.Observer, meta lint.LintMetadata, input lint.InputType, execute func() *lint.LintResult) *lint.LintResult {
+	if len(observers) == 0 {
+		return execute()
+	}
+	for _, o := range observers {
+		o.BeforeLint(meta, input)
+	}
+	start := time.Now()
+	res := execute()
+	duration := time.Since(start)
+	for _, o := range observers {
+		o.AfterLint(meta, input, duration, res)
 	}
+	return res
 }
 
 func (z *ResultSet) updateErrorStatePresent(result *lint.LintResult) {
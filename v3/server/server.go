@@ -0,0 +1,403 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+// Package server implements a local HTTP JSON API for linting certificates,
+// CRLs and OCSP responses, so that components that are not written in Go can
+// use ZLint without starting a process per input. It is served by
+// `zlint serve`.
+//
+// The API has the following endpoints:
+//
+//	GET  /health            reports that the service is running
+//	GET  /lints             lists the lints that the service runs
+//	GET  /profiles          lists the lint profiles that may be requested
+//	POST /lint/certificate  lints a single certificate
+//	POST /lint/crl          lints a single CRL
+//	POST /lint/ocsp         lints a single OCSP response
+//	POST /lint/chain        lints each certificate of a chain
+//
+// The body of a POST request is either the PEM or DER encoded input, with any
+// parameters given in the query string, or a JSON encoded Request when the
+// Content-Type is application/json. The lint endpoints respond with the
+// zlint.ResultSet of the input, or with a JSON array of ResultSets in the
+// order of the certificates for /lint/chain. Errors are reported as a JSON
+// object with a single "error" member.
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+	"golang.org/x/crypto/ocsp"
+)
+
+// DefaultMaxRequestBytes is the largest request body accepted by a Handler
+// constructed with a non-positive maxRequestBytes.
+const DefaultMaxRequestBytes = 1 << 20
+
+// Request holds the input and parameters of a lint request. When the body of
+// a request is not JSON, the parameters are instead read from the query
+// parameters of the same names, and the input is the body itself.
+type Request struct {
+	// Input is the PEM encoded input, or the base64 encoding of the DER
+	// encoded input.
+	Input string `json:"input"`
+	// NameFilter, IncludeNames, ExcludeNames, IncludeSources, ExcludeSources
+	// and Profile select the lints to run, as the zlint command line flags of
+	// the same names do. Lists are comma separated.
+	NameFilter     string `json:"nameFilter,omitempty"`
+	IncludeNames   string `json:"includeNames,omitempty"`
+	ExcludeNames   string `json:"excludeNames,omitempty"`
+	IncludeSources string `json:"includeSources,omitempty"`
+	ExcludeSources string `json:"excludeSources,omitempty"`
+	Profile        string `json:"profile,omitempty"`
+	// Config is a TOML lint configuration, as accepted by the -config flag of
+	// zlint, used in place of the configuration of the Handler's registry.
+	// Settings that name files to be read are rejected.
+	// Its [Filter] section selects lints as the parameters above do.
+	Config string `json:"config,omitempty"`
+}
+
+// Handler is an http.Handler that serves the linting API using the lints of
+// a registry. A Handler is safe for concurrent use.
+type Handler struct {
+	registry        lint.Registry
+	maxRequestBytes int64
+	mux             *http.ServeMux
+}
+
+// NewHandler returns a Handler that runs the lints of registry, or of the
+// global registry if registry is nil, and rejects request bodies larger than
+// maxRequestBytes. A non-positive maxRequestBytes uses
+// DefaultMaxRequestBytes.
+func NewHandler(registry lint.Registry, maxRequestBytes int64) *Handler {
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	if maxRequestBytes <= 0 {
+		maxRequestBytes = DefaultMaxRequestBytes
+	}
+	h := &Handler{
+		registry:        registry,
+		maxRequestBytes: maxRequestBytes,
+		mux:             http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET /health", h.health)
+	h.mux.HandleFunc("GET /lints", h.lints)
+	h.mux.HandleFunc("GET /profiles", h.profiles)
+	h.mux.HandleFunc("POST /lint/certificate", h.lintCertificate)
+	h.mux.HandleFunc("POST /lint/crl", h.lintRevocationList)
+	h.mux.HandleFunc("POST /lint/ocsp", h.lintOcspResponse)
+	h.mux.HandleFunc("POST /lint/chain", h.lintChain)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) health(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status":  "ok",
+		"version": zlint.Version,
+	})
+}
+
+func (h *Handler) lints(w http.ResponseWriter, _ *http.Request) {
+	var lints []lint.LintMetadata
+	for _, l := range h.registry.CertificateLints().Lints() {
+		lints = append(lints, l.LintMetadata)
+	}
+	for _, l := range h.registry.RevocationListLints().Lints() {
+		lints = append(lints, l.LintMetadata)
+	}
+	for _, l := range h.registry.OcspResponseLints().Lints() {
+		lints = append(lints, l.LintMetadata)
+	}
+	for _, l := range lint.RawCertificateLintsOf(h.registry) {
+		lints = append(lints, l.LintMetadata)
+	}
+	for _, l := range lint.CertificateRequestLintsOf(h.registry) {
+		lints = append(lints, l.LintMetadata)
+	}
+	sort.Slice(lints, func(i, j int) bool {
+		return lints[i].Name < lints[j].Name
+	})
+	writeJSON(w, http.StatusOK, lints)
+}
+
+func (h *Handler) profiles(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, lint.AllProfiles())
+}
+
+func (h *Handler) lintCertificate(w http.ResponseWriter, r *http.Request) {
+	inputs, registry, ok := h.readRequest(w, r, "CERTIFICATE")
+	if !ok {
+		return
+	}
+	if len(inputs) != 1 {
+		writeError(w, http.StatusBadRequest, errors.New("expected a single certificate"))
+		return
+	}
+	writeJSON(w, http.StatusOK, zlint.LintRawCertificateEx(inputs[0], registry))
+}
+
+func (h *Handler) lintRevocationList(w http.ResponseWriter, r *http.Request) {
+	inputs, registry, ok := h.readRequest(w, r, "X509 CRL")
+	if !ok {
+		return
+	}
+	if len(inputs) != 1 {
+		writeError(w, http.StatusBadRequest, errors.New("expected a single CRL"))
+		return
+	}
+	writeJSON(w, http.StatusOK, zlint.LintRawRevocationListEx(inputs[0], registry))
+}
+
+func (h *Handler) lintOcspResponse(w http.ResponseWriter, r *http.Request) {
+	inputs, registry, ok := h.readRequest(w, r, "OCSP RESPONSE")
+	if !ok {
+		return
+	}
+	if len(inputs) != 1 {
+		writeError(w, http.StatusBadRequest, errors.New("expected a single OCSP response"))
+		return
+	}
+	o, err := ocsp.ParseResponse(inputs[0], nil)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, fmt.Errorf("unable to parse OCSP response: %w", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, zlint.LintOcspResponseEx(o, registry))
+}
+
+func (h *Handler) lintChain(w http.ResponseWriter, r *http.Request) {
+	inputs, registry, ok := h.readRequest(w, r, "CERTIFICATE")
+	if !ok {
+		return
+	}
+	// A DER encoded chain is a concatenation of certificates.
+	if len(inputs) == 1 {
+		chain, err := splitDER(inputs[0])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		inputs = chain
+	}
+	results := make([]*zlint.ResultSet, 0, len(inputs))
+	for _, der := range inputs {
+		results = append(results, zlint.LintRawCertificateEx(der, registry))
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+// readRequest reads the input and parameters of r, returning the DER of each
+// input and the registry of lints to run. PEM encoded inputs must have the
+// given PEM type. If the request is invalid then an error is written to w and
+// ok is false.
+func (h *Handler) readRequest(w http.ResponseWriter, r *http.Request, pemType string) (inputs [][]byte, registry lint.Registry, ok bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxRequestBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body is larger than %d bytes", tooLarge.Limit))
+		} else {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unable to read request body: %w", err))
+		}
+		return nil, nil, false
+	}
+
+	var req Request
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/json" {
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unable to decode request: %w", err))
+			return nil, nil, false
+		}
+		body = []byte(req.Input)
+		if !isPEM(body) {
+			if body, err = base64.StdEncoding.DecodeString(req.Input); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("unable to decode input: %w", err))
+				return nil, nil, false
+			}
+		}
+	} else {
+		query := r.URL.Query()
+		req = Request{
+			NameFilter:     query.Get("nameFilter"),
+			IncludeNames:   query.Get("includeNames"),
+			ExcludeNames:   query.Get("excludeNames"),
+			IncludeSources: query.Get("includeSources"),
+			ExcludeSources: query.Get("excludeSources"),
+			Profile:        query.Get("profile"),
+			Config:         query.Get("config"),
+		}
+	}
+
+	if inputs, err = decodeInputs(body, pemType); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, nil, false
+	}
+	if registry, err = h.requestRegistry(req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, nil, false
+	}
+	return inputs, registry, true
+}
+
+// requestRegistry returns the registry of lints selected by the parameters of
+// req. The lints are selected from those of the Handler's registry by the
+// [Filter] section of the request's configuration, if any, with each
+// parameter that is set replacing the corresponding setting of the section.
+//
+//nolint:cyclop
+func (h *Handler) requestRegistry(req Request) (lint.Registry, error) {
+	var config lint.Configuration
+	var filter lint.FilterConfig
+	if req.Config != "" {
+		var err error
+		if config, err = lint.NewConfigFromString(req.Config); err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+		// Settings that name files would let any client make the service
+		// read files of its choosing, and so may only be configured for the
+		// Handler's registry.
+		if settings := config.FileSettings(h.registry); len(settings) != 0 {
+			return nil, fmt.Errorf("invalid config: settings that name files may not be configured per request: %s",
+				strings.Join(settings, ", "))
+		}
+		if filter, err = config.FilterConfig(); err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+	}
+	filter = filter.Override(lint.FilterFlags{
+		NameFilter:     req.NameFilter,
+		IncludeNames:   req.IncludeNames,
+		ExcludeNames:   req.ExcludeNames,
+		IncludeSources: req.IncludeSources,
+		ExcludeSources: req.ExcludeSources,
+		Profile:        req.Profile,
+	})
+	opts, err := filter.Options()
+	if err != nil {
+		return nil, err
+	}
+	registry, err := h.registry.Filter(opts)
+	if err != nil {
+		return nil, err
+	}
+	if req.Config == "" {
+		return registry, nil
+	}
+
+	// The registry returned by Filter may be shared with other requests, so
+	// the lints it selected are configured in a registry of their own.
+	return reconfigure(registry, config)
+}
+
+// reconfigure returns a registry of the lints of registry, which need not have
+// been globally registered, configured by config.
+func reconfigure(registry lint.Registry, config lint.Configuration) (lint.Registry, error) {
+	builder := lint.NewRegistryBuilder()
+	for _, l := range registry.CertificateLints().Lints() {
+		builder.AddCertificateLint(l)
+	}
+	for _, l := range registry.RevocationListLints().Lints() {
+		builder.AddRevocationListLint(l)
+	}
+	for _, l := range registry.OcspResponseLints().Lints() {
+		builder.AddOcspResponseLint(l)
+	}
+	for _, l := range lint.RawCertificateLintsOf(registry) {
+		builder.AddRawCertificateLint(l)
+	}
+	for _, l := range lint.CertificateRequestLintsOf(registry) {
+		builder.AddCertificateRequestLint(l)
+	}
+	return builder.WithConfiguration(config).Build()
+}
+
+// decodeInputs returns the DER of each PEM block of data, which must have the
+// given PEM type, or data itself if it is not PEM encoded.
+func decodeInputs(data []byte, pemType string) ([][]byte, error) {
+	if !isPEM(data) {
+		if len(data) == 0 {
+			return nil, errors.New("missing input")
+		}
+		return [][]byte{data}, nil
+	}
+	var inputs [][]byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != pemType {
+			return nil, fmt.Errorf("unexpected PEM type (%s), expected %s", block.Type, pemType)
+		}
+		inputs = append(inputs, block.Bytes)
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("unable to parse PEM")
+	}
+	return inputs, nil
+}
+
+// splitDER splits a concatenation of DER encoded elements into its elements.
+func splitDER(data []byte) ([][]byte, error) {
+	var elements [][]byte
+	for len(data) > 0 {
+		var element asn1.RawValue
+		rest, err := asn1.Unmarshal(data, &element)
+		if err != nil {
+			return nil, fmt.Errorf("unable to split chain: %w", err)
+		}
+		elements = append(elements, element.FullBytes)
+		data = rest
+	}
+	return elements, nil
+}
+
+// isPEM reports whether data holds PEM, which may be preceded by explanatory
+// text such as that written by `openssl x509 -text`.
+func isPEM(data []byte) bool {
+	return bytes.Contains(data, []byte("-----BEGIN "))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	//nolint:errchkjson
+	_ = enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
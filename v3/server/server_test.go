@@ -0,0 +1,308 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zlint/v3"
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func readTestData(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// do sends a request to a Handler for the global registry, decoding the
+// response into out, and returns the response status.
+func do(t *testing.T, method, target, contentType, body string, out interface{}) int {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	NewHandler(nil, 0).ServeHTTP(rec, req)
+	if out != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+			t.Fatalf("unable to decode response %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec.Code
+}
+
+func TestHealthAndListings(t *testing.T) {
+	var health map[string]interface{}
+	if status := do(t, http.MethodGet, "/health", "", "", &health); status != http.StatusOK || health["status"] != "ok" {
+		t.Errorf("expected a healthy status, got %d %v", status, health)
+	}
+
+	var lints []lint.LintMetadata
+	do(t, http.MethodGet, "/lints", "", "", &lints)
+	if len(lints) != len(lint.GlobalRegistry().Names()) {
+		t.Errorf("expected %d lints, got %d", len(lint.GlobalRegistry().Names()), len(lints))
+	}
+
+	var profiles []lint.Profile
+	do(t, http.MethodGet, "/profiles", "", "", &profiles)
+	if len(profiles) != len(lint.AllProfiles()) {
+		t.Errorf("expected %d profiles, got %d", len(lint.AllProfiles()), len(profiles))
+	}
+
+	if status := do(t, http.MethodGet, "/lint/certificate", "", "", nil); status != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, status)
+	}
+}
+
+func TestLintEndpoints(t *testing.T) {
+	cert := string(readTestData(t, "27monthsEv.pem"))
+	block, _ := pem.Decode([]byte(cert))
+	crl := string(readTestData(t, "crlWithNoDuplicatesInRevokedCertificateList.pem"))
+	ocspRequest, err := json.Marshal(Request{Input: string(readTestData(t, "ocspThisUpdateNotAfterProducedAt"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Target      string
+		ContentType string
+		Body        string
+		ExpectLint  string
+	}{
+		{
+			Name:       "PEM certificate",
+			Target:     "/lint/certificate",
+			Body:       cert,
+			ExpectLint: "e_sub_cert_valid_time_longer_than_825_days",
+		},
+		{
+			Name:       "DER certificate",
+			Target:     "/lint/certificate",
+			Body:       string(block.Bytes),
+			ExpectLint: "e_sub_cert_valid_time_longer_than_825_days",
+		},
+		{
+			Name:       "unparseable certificate",
+			Target:     "/lint/certificate",
+			Body:       "\x30\x03\x02\x01",
+			ExpectLint: "e_cert_unparseable",
+		},
+		{
+			Name:       "CRL",
+			Target:     "/lint/crl",
+			Body:       crl,
+			ExpectLint: "e_crl_unique_revoked_certificate",
+		},
+		{
+			Name:        "JSON OCSP response",
+			Target:      "/lint/ocsp",
+			ContentType: "application/json",
+			Body:        string(ocspRequest),
+			ExpectLint:  "e_this_update_not_after_produced_at",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var res zlint.ResultSet
+			if status := do(t, http.MethodPost, tc.Target, tc.ContentType, tc.Body, &res); status != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+			}
+			if res.Results[tc.ExpectLint] == nil {
+				t.Errorf("expected a result for %s", tc.ExpectLint)
+			}
+		})
+	}
+}
+
+func TestLintParameters(t *testing.T) {
+	cert := readTestData(t, "27monthsEv.pem")
+	block, _ := pem.Decode(cert)
+
+	var res zlint.ResultSet
+	do(t, http.MethodPost, "/lint/certificate?includeNames=e_sub_cert_valid_time_longer_than_825_days", "", string(cert), &res)
+	if len(res.Results) != 1 || res.Results["e_sub_cert_valid_time_longer_than_825_days"] == nil {
+		t.Errorf("expected only the included lint to run, got %v", res.Results)
+	}
+
+	req, _ := json.Marshal(Request{
+		Input:        base64.StdEncoding.EncodeToString(block.Bytes),
+		IncludeNames: "e_sub_cert_valid_time_longer_than_825_days, e_cert_unparseable",
+		Config:       "[Global]\n",
+	})
+	res = zlint.ResultSet{}
+	do(t, http.MethodPost, "/lint/certificate", "application/json", string(req), &res)
+	if len(res.Results) != 1 || res.Results["e_sub_cert_valid_time_longer_than_825_days"] == nil {
+		t.Errorf("expected only the included certificate lint to run, got %v", res.Results)
+	}
+
+	res = zlint.ResultSet{}
+	config := "[Filter]\nIncludeNames = [\"e_cert_unparseable\", \"e_sub_cert_valid_time_longer_than_825_days\"]\n"
+	do(t, http.MethodPost, "/lint/certificate?config="+url.QueryEscape(config), "", string(cert), &res)
+	if len(res.Results) != 1 || res.Results["e_sub_cert_valid_time_longer_than_825_days"] == nil {
+		t.Errorf("expected only the lints of the [Filter] section to run, got %v", res.Results)
+	}
+
+	var chain []zlint.ResultSet
+	do(t, http.MethodPost, "/lint/chain?profile=", "", string(cert)+string(cert), &chain)
+	if len(chain) != 2 {
+		t.Errorf("expected 2 results, got %d", len(chain))
+	}
+	chain = nil
+	do(t, http.MethodPost, "/lint/chain", "", string(block.Bytes)+string(block.Bytes), &chain)
+	if len(chain) != 2 {
+		t.Errorf("expected 2 results, got %d", len(chain))
+	}
+}
+
+func TestBadRequests(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Target       string
+		ContentType  string
+		Body         string
+		ExpectStatus int
+	}{
+		{
+			Name:         "missing input",
+			Target:       "/lint/certificate",
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "wrong PEM type",
+			Target:       "/lint/crl",
+			Body:         string(readTestData(t, "27monthsEv.pem")),
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "unknown lint",
+			Target:       "/lint/certificate?includeNames=e_no_such_lint",
+			Body:         "\x30\x00",
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "unknown profile",
+			Target:       "/lint/certificate?profile=no_such_profile",
+			Body:         "\x30\x00",
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "invalid JSON",
+			Target:       "/lint/certificate",
+			ContentType:  "application/json",
+			Body:         "{",
+			ExpectStatus: http.StatusBadRequest,
+		},
+		{
+			Name:         "unparseable OCSP response",
+			Target:       "/lint/ocsp",
+			Body:         "\x30\x00",
+			ExpectStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			Name:         "too large",
+			Target:       "/lint/certificate",
+			Body:         strings.Repeat("A", DefaultMaxRequestBytes+1),
+			ExpectStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var res map[string]string
+			if status := do(t, http.MethodPost, tc.Target, tc.ContentType, tc.Body, &res); status != tc.ExpectStatus {
+				t.Errorf("expected status %d, got %d", tc.ExpectStatus, status)
+			}
+			if res["error"] == "" {
+				t.Error("expected an error message")
+			}
+		})
+	}
+}
+
+type handlerTestLint struct {
+	Warn      bool
+	Blocklist string `file:"true"`
+}
+
+func (l *handlerTestLint) Configure() interface{} {
+	return l
+}
+
+func (l *handlerTestLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *handlerTestLint) Execute(c *x509.Certificate) *lint.LintResult {
+	if l.Warn {
+		return &lint.LintResult{Status: lint.Warn}
+	}
+	return &lint.LintResult{Status: lint.Pass}
+}
+
+// TestCustomRegistryConfig checks that a request configuration configures the
+// lints of the Handler's registry, which need not be globally registered, and
+// that it may not name files for them to read.
+func TestCustomRegistryConfig(t *testing.T) {
+	registry, err := lint.NewRegistryBuilder().
+		AddCertificateLint(&lint.CertificateLint{
+			LintMetadata: lint.LintMetadata{Name: "e_handler_test_lint", Source: lint.Community},
+			Lint:         func() lint.CertificateLintInterface { return &handlerTestLint{} },
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := NewHandler(registry, 0)
+	post := func(config string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/lint/certificate?config="+url.QueryEscape(config),
+			strings.NewReader(string(readTestData(t, "27monthsEv.pem"))))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post("[e_handler_test_lint]\nWarn = true\n")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var res zlint.ResultSet
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 1 || res.Results["e_handler_test_lint"] == nil || res.Results["e_handler_test_lint"].Status != lint.Warn {
+		t.Errorf("expected a configured e_handler_test_lint warning, got %v", res.Results)
+	}
+
+	rec = post("[e_handler_test_lint]\nBlocklist = \"/etc/passwd\"\n")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for a config naming a file, got %d: %s", http.StatusBadRequest, rec.Code, rec.Body.String())
+	}
+}
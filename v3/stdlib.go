@@ -0,0 +1,93 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	stdx509 "crypto/x509"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+// LintStdlibCertificate runs all registered lints on a certificate parsed by
+// the crypto/x509 package of the standard library, producing a ResultSet.
+//
+// Using this function is equivalent to calling LintStdlibCertificateEx with
+// a nil registry.
+func LintStdlibCertificate(c *stdx509.Certificate) *ResultSet {
+	return LintStdlibCertificateEx(c, nil)
+}
+
+// LintStdlibCertificateEx lints a certificate parsed by the crypto/x509 package
+// of the standard library, by parsing its DER with zcrypto as
+// LintRawCertificateEx does. A certificate that zcrypto can not parse is
+// therefore still linted by the raw certificate lints of registry, with the
+// parsing error reported as a Fatal e_cert_unparseable result.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintStdlibCertificateEx(c *stdx509.Certificate, registry lint.Registry, opts ...Option) *ResultSet {
+	if c == nil {
+		return nil
+	}
+	return LintRawCertificateEx(c.Raw, registry, opts...)
+}
+
+// LintStdlibRevocationList runs all registered lints on a revocation list
+// parsed by the crypto/x509 package of the standard library, producing
+// a ResultSet.
+//
+// Using this function is equivalent to calling LintStdlibRevocationListEx with
+// a nil registry.
+func LintStdlibRevocationList(r *stdx509.RevocationList) *ResultSet {
+	return LintStdlibRevocationListEx(r, nil)
+}
+
+// LintStdlibRevocationListEx lints a revocation list parsed by the crypto/x509
+// package of the standard library, by parsing its DER with zcrypto as
+// LintRawRevocationListEx does. A revocation list that zcrypto can not parse
+// produces a Fatal e_crl_unparseable result.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintStdlibRevocationListEx(r *stdx509.RevocationList, registry lint.Registry, opts ...Option) *ResultSet {
+	if r == nil {
+		return nil
+	}
+	return LintRawRevocationListEx(r.Raw, registry, opts...)
+}
+
+// LintStdlibCertificateRequest runs all registered lints on a certificate
+// signing request parsed by the crypto/x509 package of the standard library,
+// producing a ResultSet.
+//
+// Using this function is equivalent to calling LintStdlibCertificateRequestEx
+// with a nil registry.
+func LintStdlibCertificateRequest(r *stdx509.CertificateRequest) *ResultSet {
+	return LintStdlibCertificateRequestEx(r, nil)
+}
+
+// LintStdlibCertificateRequestEx lints a certificate signing request parsed by
+// the crypto/x509 package of the standard library, by parsing its DER with
+// zcrypto as LintRawCertificateRequestEx does. A request that zcrypto can not
+// parse produces a Fatal e_csr_unparseable result.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintStdlibCertificateRequestEx(r *stdx509.CertificateRequest, registry lint.Registry, opts ...Option) *ResultSet {
+	if r == nil {
+		return nil
+	}
+	return LintRawCertificateRequestEx(r.Raw, registry, opts...)
+}
@@ -0,0 +1,132 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package zlint
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/zmap/zlint/v3/lint"
+)
+
+func TestLintStdlibCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &stdx509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              stdx509.KeyUsageCertSign | stdx509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := stdx509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := stdx509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := LintStdlibCertificate(c)
+	want := LintRawCertificate(der)
+	if len(res.Results) == 0 || len(res.Results) != len(want.Results) {
+		t.Fatalf("expected %d results, got %d", len(want.Results), len(res.Results))
+	}
+	for name, result := range want.Results {
+		if got := res.Results[name]; got == nil || got.Status != result.Status {
+			t.Errorf("%s: expected %+v, got %+v", name, result, got)
+		}
+	}
+
+	crlDER, err := stdx509.CreateRevocationList(rand.Reader, &stdx509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Hour),
+		NextUpdate: time.Now().Add(time.Hour),
+	}, c, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crl, err := stdx509.ParseRevocationList(crlDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = LintStdlibRevocationList(crl)
+	if len(res.Results) == 0 || res.Results["e_crl_unparseable"] != nil {
+		t.Errorf("expected the revocation list lints to run, got %v", res.Results)
+	}
+
+	csrDER, err := stdx509.CreateCertificateRequest(rand.Reader, &stdx509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csr, err := stdx509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res = LintStdlibCertificateRequest(csr)
+	if res == nil || res.Results["e_csr_unparseable"] != nil || res.FatalsPresent {
+		t.Errorf("expected the certificate signing request lints to run, got %v", res.Results)
+	}
+}
+
+func TestLintRawRevocationList(t *testing.T) {
+	res := LintRawRevocationList([]byte{0x30, 0x03, 0x02, 0x01})
+	if len(res.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(res.Results))
+	}
+	if result := res.Results["e_crl_unparseable"]; result == nil || result.Status != lint.Fatal {
+		t.Errorf("expected a fatal e_crl_unparseable result, got %+v", result)
+	}
+	if !res.FatalsPresent {
+		t.Error("expected FatalsPresent to be set")
+	}
+}
+
+func TestLintRawCertificateRequest(t *testing.T) {
+	res := LintRawCertificateRequest([]byte{0x30, 0x03, 0x02, 0x01})
+	if len(res.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(res.Results))
+	}
+	if result := res.Results["e_csr_unparseable"]; result == nil || result.Status != lint.Fatal {
+		t.Errorf("expected a fatal e_csr_unparseable result, got %+v", result)
+	}
+	if !res.FatalsPresent {
+		t.Error("expected FatalsPresent to be set")
+	}
+}
+
+// TestUnparseableNamesReserved checks that no lint is registered under the
+// names that inputs which can not be parsed are reported under.
+func TestUnparseableNamesReserved(t *testing.T) {
+	for _, name := range lint.GlobalRegistry().Names() {
+		if name == crlUnparseable.Name || name == csrUnparseable.Name {
+			t.Errorf("expected no lint to be registered as %s", name)
+		}
+	}
+}
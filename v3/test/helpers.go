@@ -27,6 +27,7 @@ import (
 
 	"github.com/zmap/zcrypto/x509"
 	"github.com/zmap/zlint/v3/lint"
+	"github.com/zmap/zlint/v3/util"
 	"golang.org/x/crypto/ocsp"
 )
 
@@ -163,6 +164,55 @@ func TestLintOCSPResponse(tb testing.TB, lintName string, ocspResponse *ocsp.Res
 	return res
 }
 
+// TestLintRawCertificate executes a raw certificate lint with the given name
+// against the DER encoded certificate der. This is useful when a unit test
+// needs to lint a certificate that can not be parsed, and so can not be stored
+// as a test certificate.
+//
+//nolint:revive
+func TestLintRawCertificate(tb testing.TB, lintName string, der []byte, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().(lint.RawCertificateRegistry).RawCertificateLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	raw, _ := util.ParseRawCertificate(der)
+	res := l.Execute(raw, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test raw certificate generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
+// TestLintCertificateRequest executes a certificate signing request lint with
+// the given name against an already parsed request.
+//
+//nolint:revive
+func TestLintCertificateRequest(tb testing.TB, lintName string, csr *x509.CertificateRequest, ctx lint.Configuration) *lint.LintResult {
+	tb.Helper()
+	l := lint.GlobalRegistry().(lint.CertificateRequestRegistry).CertificateRequestLints().ByName(lintName)
+	if l == nil {
+		tb.Fatalf(
+			"Lint name %q does not exist in lint.Lints. "+
+				"Did you forget to RegisterLint?\n",
+			lintName)
+	}
+	res := l.Execute(csr, ctx)
+	// We never expect a lint to return a nil LintResult
+	if res == nil {
+		tb.Fatalf(
+			"Running lint %q on test certificate signing request generated a nil LintResult.\n",
+			lintName)
+	}
+	return res
+}
+
 // ReadTestCert loads a x509.Certificate from the given inPath which is assumed
 // to be relative to `testdata/`.
 //
@@ -238,6 +288,41 @@ func ReadTestRevocationList(tb testing.TB, inPath string) *x509.RevocationList {
 	return theCrl
 }
 
+// ReadTestCertificateRequest loads a x509.CertificateRequest from the given
+// PEM encoded inPath which is assumed to be relative to `testdata/`.
+//
+// Important: ReadTestCertificateRequest is only appropriate for unit tests. It
+// will fail the test if the inPath file can not be loaded.
+func ReadTestCertificateRequest(tb testing.TB, inPath string) *x509.CertificateRequest {
+	tb.Helper()
+	fullPath := "../../testdata/" + inPath
+	data, err := os.ReadFile(fullPath)
+	if err != nil {
+		tb.Fatalf(
+			"Unable to read test certificate signing request from %q - %q "+
+				"Does a unit test have an incorrect test file name?\n",
+			fullPath, err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil { //nolint: staticcheck // tb.Fatalf exits
+		tb.Fatalf(
+			"Failed to PEM decode test certificate signing request from %q - "+
+				"Does a unit test have a buggy test file?\n",
+			fullPath)
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes) //nolint: staticcheck // tb.Fatalf exits
+	if err != nil {
+		tb.Fatalf(
+			"Failed to parse test certificate signing request from %q - %q "+
+				"Does a unit test have a buggy test file?\n",
+			fullPath, err)
+	}
+
+	return csr
+}
+
 // ReadTestOCSPResponse loads a ocsp.Response from the given inPath which is assumed
 // to be relative to `testdata/`. The OCSP file must contain the OCSP response in
 // Base64 encoding. openssl ocsp -resp_text -respin <(base64 -d the_filename)
@@ -0,0 +1,71 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"math/big"
+)
+
+// BatchGCD returns, for each of the moduli, its greatest common divisor with
+// the product of all of the other moduli. An RSA modulus that shares a prime
+// with another of the moduli, and so can be factored, has a result other than
+// one. The result is the modulus itself if both of its primes are shared.
+//
+// Rather than computing the GCD of every pair of moduli, the product of all of
+// the moduli is computed with a product tree, and its remainder modulo the
+// square of each modulus with a remainder tree, following Heninger et al.[0].
+// The moduli should be distinct, as a modulus that appears twice shares both of
+// its primes with itself.
+//
+// [0]: https://factorable.net/weakkeys12.extended.pdf
+func BatchGCD(moduli []*big.Int) []*big.Int {
+	if len(moduli) == 0 {
+		return nil
+	}
+	// levels[0] holds the moduli and each following level the products of
+	// pairs of the level below, up to the product of all of the moduli.
+	levels := [][]*big.Int{moduli}
+	for level := moduli; len(level) > 1; {
+		products := make([]*big.Int, (len(level)+1)/2)
+		for i := range products {
+			if 2*i+1 < len(level) {
+				products[i] = new(big.Int).Mul(level[2*i], level[2*i+1])
+			} else {
+				products[i] = level[2*i]
+			}
+		}
+		levels = append(levels, products)
+		level = products
+	}
+	// Descend the tree reducing the product modulo the square of each node.
+	remainders := levels[len(levels)-1]
+	for l := len(levels) - 2; l >= 0; l-- {
+		next := make([]*big.Int, len(levels[l]))
+		square := new(big.Int)
+		for i, n := range levels[l] {
+			square.Mul(n, n)
+			next[i] = new(big.Int).Mod(remainders[i/2], square)
+		}
+		remainders = next
+	}
+	gcds := make([]*big.Int, len(moduli))
+	for i, n := range moduli {
+		// The remainder divided by n is the product of the other moduli
+		// modulo n.
+		quotient := new(big.Int).Quo(remainders[i], n)
+		gcds[i] = new(big.Int).GCD(nil, nil, quotient, n)
+	}
+	return gcds
+}
@@ -0,0 +1,81 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestBatchGCD(t *testing.T) {
+	moduli := []*big.Int{
+		big.NewInt(11 * 13),
+		big.NewInt(17 * 19),
+		big.NewInt(11 * 23),
+		big.NewInt(29 * 31),
+		// Both primes are shared.
+		big.NewInt(13 * 23),
+	}
+	want := []int64{11 * 13, 1, 11 * 23, 1, 13 * 23}
+	got := BatchGCD(moduli)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i].Int64() != want[i] {
+			t.Errorf("BatchGCD()[%d] = %s, want %d", i, got[i], want[i])
+		}
+	}
+	if got := BatchGCD(moduli[:1]); got[0].Int64() != 1 {
+		t.Errorf("expected a single modulus to share nothing, got %s", got[0])
+	}
+	if got := BatchGCD(nil); got != nil {
+		t.Errorf("expected no results, got %v", got)
+	}
+}
+
+func TestBatchGCDMatchesPairwiseGCD(t *testing.T) {
+	primes := make([]*big.Int, 40)
+	for i := range primes {
+		p, err := rand.Prime(rand.Reader, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		primes[i] = p
+	}
+	// Each modulus shares a prime with the next, other than every fourth.
+	var moduli []*big.Int
+	for i := 0; i+1 < len(primes); i += 2 {
+		q := primes[i+1]
+		if i%4 == 0 && i+2 < len(primes) {
+			q = primes[i+2]
+		}
+		moduli = append(moduli, new(big.Int).Mul(primes[i], q))
+	}
+	got := BatchGCD(moduli)
+	for i, n := range moduli {
+		want := big.NewInt(1)
+		for j, m := range moduli {
+			if i != j {
+				want.Mul(want, new(big.Int).GCD(nil, nil, n, m))
+			}
+		}
+		want.GCD(nil, nil, want, n)
+		if got[i].Cmp(want) != 0 {
+			t.Errorf("BatchGCD()[%d] = %s, want %s", i, got[i], want)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+)
+
+// CTLogState is the state of a CT log within a log list.
+type CTLogState string
+
+const (
+	CTLogPending   CTLogState = "pending"
+	CTLogQualified CTLogState = "qualified"
+	CTLogUsable    CTLogState = "usable"
+	CTLogReadOnly  CTLogState = "readonly"
+	CTLogRetired   CTLogState = "retired"
+	CTLogRejected  CTLogState = "rejected"
+)
+
+// CTLog is a CT log of a log list, such as the ones published by Apple[0] and
+// Chrome[1] following the v3 log list schema[2].
+//
+// [0]: https://valid.apple.com/ct/log_list/current_log_list.json
+// [1]: https://www.gstatic.com/ct/log_list/v3/log_list.json
+// [2]: https://www.gstatic.com/ct/log_list/v3/log_list_schema.json
+type CTLog struct {
+	Description string
+	LogID       ct.SHA256Hash
+	// Key is the DER encoded SubjectPublicKeyInfo of the log's public key.
+	Key []byte
+	// Operator is the name of the organization operating the log.
+	Operator string
+	// State is the current state of the log, and StateTimestamp the time at
+	// which the log entered it. State is empty if the log list does not give
+	// the log exactly one state, in which case the log is never approved.
+	State          CTLogState
+	StateTimestamp time.Time
+	// TemporalIntervalStart and TemporalIntervalEnd bound the expiry of the
+	// certificates that a sharded log accepts. Both are zero for a log that is
+	// not sharded.
+	TemporalIntervalStart time.Time
+	TemporalIntervalEnd   time.Time
+}
+
+// IsApproved reports whether the log is currently approved, that is whether it
+// is qualified, usable or read-only.
+func (l *CTLog) IsApproved() bool {
+	switch l.State {
+	case CTLogQualified, CTLogUsable, CTLogReadOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// WasApprovedAt reports whether an SCT issued by the log at the given time is
+// from a once or currently approved log. That is whether the log is currently
+// approved, or was retired after the given time.
+func (l *CTLog) WasApprovedAt(when time.Time) bool {
+	return l.IsApproved() || (l.State == CTLogRetired && when.Before(l.StateTimestamp))
+}
+
+// Covers reports whether a certificate expiring at notAfter falls within the
+// temporal interval of the log.
+func (l *CTLog) Covers(notAfter time.Time) bool {
+	if l.TemporalIntervalStart.IsZero() && l.TemporalIntervalEnd.IsZero() {
+		return true
+	}
+	return !notAfter.Before(l.TemporalIntervalStart) && notAfter.Before(l.TemporalIntervalEnd)
+}
+
+// CTLogList is a CT log list following the v3 log list schema, indexed by log
+// ID.
+type CTLogList struct {
+	Version   string
+	Timestamp time.Time
+	logs      map[ct.SHA256Hash]*CTLog
+}
+
+// ParseCTLogList parses a log list in the JSON v3 log list schema. Both the
+// RFC 6962 logs and the static CT API tiled logs of each operator are indexed.
+func ParseCTLogList(data []byte) (*CTLogList, error) {
+	var raw struct {
+		Version   string    `json:"version"`
+		Timestamp time.Time `json:"log_list_timestamp"`
+		Operators []struct {
+			Name      string      `json:"name"`
+			Logs      []jsonCTLog `json:"logs"`
+			TiledLogs []jsonCTLog `json:"tiled_logs"`
+		} `json:"operators"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing CT log list: %w", err)
+	}
+	list := &CTLogList{
+		Version:   raw.Version,
+		Timestamp: raw.Timestamp,
+		logs:      make(map[ct.SHA256Hash]*CTLog),
+	}
+	for _, operator := range raw.Operators {
+		for _, log := range append(operator.Logs, operator.TiledLogs...) {
+			parsed := log.ctLog(operator.Name)
+			list.logs[parsed.LogID] = parsed
+		}
+	}
+	return list, nil
+}
+
+// ReadCTLogList reads and parses the log list at the given path.
+func ReadCTLogList(path string) (*CTLogList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCTLogList(data)
+}
+
+// Log returns the log with the given log ID, or nil if the log is not in the
+// list.
+func (l *CTLogList) Log(id ct.SHA256Hash) *CTLog {
+	return l.logs[id]
+}
+
+// EmbeddedSCTLogs resolves the SCTs embedded in the certificate to the logs
+// that issued them. It returns the distinct logs that were approved when they
+// issued an SCT and whose temporal interval covers the certificate, along with
+// a description of each SCT that did not resolve to such a log.
+func (l *CTLogList) EmbeddedSCTLogs(c *x509.Certificate) ([]*CTLog, []string) {
+	var logs []*CTLog
+	var problems []string
+	seen := make(map[ct.SHA256Hash]bool)
+	for _, sct := range c.SignedCertificateTimestampList {
+		if sct == nil || seen[sct.LogID] {
+			continue
+		}
+		log := l.Log(sct.LogID)
+		issued := time.UnixMilli(int64(sct.Timestamp)).UTC()
+		switch {
+		case log == nil:
+			problems = append(problems, fmt.Sprintf("SCT from unknown log %s", sct.LogID.Base64String()))
+		case log.State == "":
+			problems = append(problems, fmt.Sprintf("SCT from log %q whose state is not known", log.Description))
+		case !log.WasApprovedAt(issued):
+			problems = append(problems, fmt.Sprintf("SCT from %s log %q", log.State, log.Description))
+		case !log.Covers(c.NotAfter):
+			problems = append(problems, fmt.Sprintf("SCT from log %q whose temporal interval does not cover the certificate", log.Description))
+		default:
+			seen[sct.LogID] = true
+			logs = append(logs, log)
+		}
+	}
+	return logs, problems
+}
+
+// SCTCount is the outcome of counting the SCTs embedded in a certificate
+// against a CT log list under the CT policies of Apple and Chrome, which agree
+// on the number of SCTs they require.
+type SCTCount struct {
+	// Logs is the number of distinct logs whose SCTs count towards the
+	// policy, and Approved how many of them are currently approved.
+	Logs     int
+	Approved int
+	// Required is the number of SCTs from distinct logs the policy requires
+	// for the certificate.
+	Required int
+	// Problems describes each SCT that was not counted.
+	Problems []string
+}
+
+// CountEmbeddedSCTs counts the SCTs embedded in the certificate towards the CT
+// policies of Apple and Chrome. Only SCTs from logs that are currently approved
+// (qualified, usable or read-only), or that were retired after the SCT was
+// issued, are counted, and at least one of them must be from a currently
+// approved log.
+//
+// | Certificate lifetime   | # of SCTs from separate logs |
+// ---------------------------------------------------------
+// | 180 days or less       | 2                            |
+// | More than 180 days     | 3                            |
+// ---------------------------------------------------------
+func (l *CTLogList) CountEmbeddedSCTs(c *x509.Certificate) SCTCount {
+	logs, problems := l.EmbeddedSCTLogs(c)
+	count := SCTCount{Logs: len(logs), Required: 3, Problems: problems}
+	if c.NotAfter.Sub(c.NotBefore) <= 180*24*time.Hour {
+		count.Required = 2
+	}
+	for _, log := range logs {
+		if log.IsApproved() {
+			count.Approved++
+		}
+	}
+	return count
+}
+
+// Satisfied reports whether the counted SCTs meet the policy.
+func (s SCTCount) Satisfied() bool {
+	return s.Logs >= s.Required && s.Approved > 0
+}
+
+// Details describes the counted SCTs for the CT policy of the named root
+// program.
+func (s SCTCount) Details(program string) string {
+	details := fmt.Sprintf(
+		"Certificate had %d embedded SCTs from distinct logs trusted by %s, %d of them from currently approved logs. "+
+			"%s's CT policy requires %d, at least one of them from a currently approved log, for this certificate.",
+		s.Logs, program, s.Approved, program, s.Required)
+	if len(s.Problems) > 0 {
+		details += " Not counted: " + strings.Join(s.Problems, "; ") + "."
+	}
+	return details
+}
+
+// SCTOperators is the outcome of resolving the SCTs embedded in a certificate
+// to the operators of their logs under the CT policies of Apple and Chrome,
+// which both require SCTs from at least two log operators.
+type SCTOperators struct {
+	// Operators are the distinct operators, sorted, of the logs whose SCTs
+	// count towards the policy.
+	Operators []string
+}
+
+// EmbeddedSCTOperators resolves the SCTs embedded in the certificate that count
+// towards the CT policies of Apple and Chrome, as described for
+// CountEmbeddedSCTs, to the operators of their logs.
+func (l *CTLogList) EmbeddedSCTOperators(c *x509.Certificate) SCTOperators {
+	logs, _ := l.EmbeddedSCTLogs(c)
+	seen := make(map[string]bool)
+	var operators SCTOperators
+	for _, log := range logs {
+		if !seen[log.Operator] {
+			seen[log.Operator] = true
+			operators.Operators = append(operators.Operators, log.Operator)
+		}
+	}
+	sort.Strings(operators.Operators)
+	return operators
+}
+
+// Satisfied reports whether the SCTs are from enough log operators to meet the
+// policy.
+func (s SCTOperators) Satisfied() bool {
+	return len(s.Operators) >= 2
+}
+
+// Details describes the log operators of the SCTs for the CT policy of the
+// named root program.
+func (s SCTOperators) Details(program string) string {
+	names := make([]string, 0, len(s.Operators))
+	for _, operator := range s.Operators {
+		names = append(names, fmt.Sprintf("%q", operator))
+	}
+	return fmt.Sprintf(
+		"Certificate had embedded SCTs from %d log operators trusted by %s [%s]. "+
+			"%s's CT policy requires SCTs from at least two log operators.",
+		len(s.Operators), program, strings.Join(names, ", "), program)
+}
+
+// jsonCTLog is a log of the v3 log list schema.
+type jsonCTLog struct {
+	Description string                       `json:"description"`
+	LogID       ct.SHA256Hash                `json:"log_id"`
+	Key         []byte                       `json:"key"`
+	State       map[CTLogState]jsonTimestamp `json:"state"`
+	Interval    *struct {
+		StartInclusive time.Time `json:"start_inclusive"`
+		EndExclusive   time.Time `json:"end_exclusive"`
+	} `json:"temporal_interval"`
+}
+
+type jsonTimestamp struct {
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ctLog converts the log. A log that does not have exactly one state is kept
+// with an empty state rather than failing the whole list, so that its SCTs are
+// still resolved to it but never counted as from an approved log.
+func (j jsonCTLog) ctLog(operator string) *CTLog {
+	log := &CTLog{
+		Description: j.Description,
+		LogID:       j.LogID,
+		Key:         j.Key,
+		Operator:    operator,
+	}
+	if len(j.State) == 1 {
+		for state, ts := range j.State {
+			log.State = state
+			log.StateTimestamp = ts.Timestamp
+		}
+	}
+	if j.Interval != nil {
+		log.TemporalIntervalStart = j.Interval.StartInclusive
+		log.TemporalIntervalEnd = j.Interval.EndExclusive
+	}
+	return log
+}
@@ -0,0 +1,224 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"encoding/pem"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+)
+
+func readTestdataCertificate(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+	data, err := os.ReadFile("../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("no PEM block in %s", name)
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestReadCTLogList(t *testing.T) {
+	list, err := ReadCTLogList("../testdata/ctLogList.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []struct {
+		logID    string
+		operator string
+		state    CTLogState
+	}{
+		{"sPdexUrNBMO9f9XyJd3u4jdA0lgOwiXKKAxbqRK6uNE=", "Example Operator A", CTLogUsable},
+		{"OHNF1ci4VnaC8qohGKs2mpjA6j9FPq01KAopKu6We+g=", "Example Operator A", CTLogRetired},
+		// A tiled log
+		{"sP1iNoosyPVFkF16ep407bj2hpyz/owbB7T9Pqh/iBw=", "Example Operator B", CTLogUsable},
+	}
+	for _, test := range data {
+		var id ct.SHA256Hash
+		if err := id.FromBase64String(test.logID); err != nil {
+			t.Fatal(err)
+		}
+		log := list.Log(id)
+		if log == nil {
+			t.Fatalf("log %s not found", test.logID)
+		}
+		if log.Operator != test.operator || log.State != test.state {
+			t.Errorf("log %s: expected %s %s got %s %s", test.logID, test.operator, test.state, log.Operator, log.State)
+		}
+	}
+	if log := list.Log(ct.SHA256Hash{}); log != nil {
+		t.Errorf("expected no log for an unknown log ID, got %v", log)
+	}
+}
+
+func TestParseCTLogListErrors(t *testing.T) {
+	data := []string{
+		`{"operators": [`,
+		`{"operators": [{"name": "A", "logs": [{"log_id": "not base64"}]}]}`,
+	}
+	for _, in := range data {
+		if _, err := ParseCTLogList([]byte(in)); err == nil {
+			t.Errorf("expected an error parsing %s", in)
+		}
+	}
+}
+
+func TestParseCTLogListUnknownState(t *testing.T) {
+	data := []string{
+		`{}`,
+		`{"usable": {"timestamp": "2020-01-01T00:00:00Z"}, "retired": {"timestamp": "2021-01-01T00:00:00Z"}}`,
+	}
+	for _, state := range data {
+		in := `{"operators": [{"name": "A", "logs": [` +
+			`{"log_id": "sPdexUrNBMO9f9XyJd3u4jdA0lgOwiXKKAxbqRK6uNE=", "state": ` + state + `}, ` +
+			`{"log_id": "OHNF1ci4VnaC8qohGKs2mpjA6j9FPq01KAopKu6We+g=", "state": {"usable": {"timestamp": "2020-01-01T00:00:00Z"}}}]}]}`
+		list, err := ParseCTLogList([]byte(in))
+		if err != nil {
+			t.Fatalf("state %s: %v", state, err)
+		}
+		var id ct.SHA256Hash
+		if err := id.FromBase64String("sPdexUrNBMO9f9XyJd3u4jdA0lgOwiXKKAxbqRK6uNE="); err != nil {
+			t.Fatal(err)
+		}
+		log := list.Log(id)
+		if log == nil || log.State != "" || log.IsApproved() {
+			t.Errorf("state %s: expected a log with no state, got %v", state, log)
+		}
+		if err := id.FromBase64String("OHNF1ci4VnaC8qohGKs2mpjA6j9FPq01KAopKu6We+g="); err != nil {
+			t.Fatal(err)
+		}
+		if log := list.Log(id); log == nil || log.State != CTLogUsable {
+			t.Errorf("state %s: expected the other log to be usable, got %v", state, log)
+		}
+	}
+}
+
+func TestCountEmbeddedSCTs(t *testing.T) {
+	data := []struct {
+		name      string
+		filename  string
+		logList   string
+		logs      int
+		required  int
+		satisfied bool
+	}{
+		{"No SCTs", "ctNoSCTs.pem", "ctLogList.json", 0, 2, false},
+		{"Lifetime <180d, 2 SCTs from usable logs", "ct3mo2SCTs.pem", "ctLogList.json", 2, 2, true},
+		{"Lifetime <180d, 2 SCTs same log", "ct3mo2DupeSCTs.pem", "ctLogList.json", 1, 2, false},
+		{"Lifetime <180d, 2 SCTs one from a rejected log", "ct3mo2SCTs.pem", "ctLogListStates.json", 1, 2, false},
+		{"Lifetime >180d, 2 SCTs", "ct18mo2SCTs.pem", "ctLogList.json", 2, 3, false},
+		{"Lifetime >180d, 3 SCTs one from a log retired after the SCT", "ct18mo3SCTs.pem", "ctLogList.json", 3, 3, true},
+		{"Lifetime >180d, 3 SCTs one from a log retired before the SCT", "ct18mo3SCTs.pem", "ctLogListStates.json", 1, 3, false},
+		{"Lifetime >180d, 5 SCTs two from unknown logs", "ct666mo5SCTs.pem", "ctLogList.json", 3, 3, true},
+	}
+	for _, test := range data {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := ReadCTLogList("../testdata/" + test.logList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			count := list.CountEmbeddedSCTs(readTestdataCertificate(t, test.filename))
+			if count.Logs != test.logs || count.Required != test.required || count.Satisfied() != test.satisfied {
+				t.Errorf("expected %d of %d SCTs satisfied %v, got %d of %d satisfied %v: %s",
+					test.logs, test.required, test.satisfied, count.Logs, count.Required, count.Satisfied(), count.Details("Test"))
+			}
+		})
+	}
+}
+
+func TestEmbeddedSCTOperators(t *testing.T) {
+	data := []struct {
+		name      string
+		filename  string
+		logList   string
+		operators int
+		satisfied bool
+	}{
+		{"No SCTs", "ctNoSCTs.pem", "ctLogList.json", 0, false},
+		{"2 SCTs from different operators", "ct3mo2SCTs.pem", "ctLogList.json", 2, true},
+		{"2 SCTs from the same log", "ct3mo2DupeSCTs.pem", "ctLogList.json", 1, false},
+		{"2 SCTs from different operators, one log rejected", "ct3mo2SCTs.pem", "ctLogListStates.json", 1, false},
+	}
+	for _, test := range data {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := ReadCTLogList("../testdata/" + test.logList)
+			if err != nil {
+				t.Fatal(err)
+			}
+			operators := list.EmbeddedSCTOperators(readTestdataCertificate(t, test.filename))
+			if len(operators.Operators) != test.operators || operators.Satisfied() != test.satisfied {
+				t.Errorf("expected %d operators satisfied %v, got %d satisfied %v: %s",
+					test.operators, test.satisfied, len(operators.Operators), operators.Satisfied(), operators.Details("Test"))
+			}
+		})
+	}
+}
+
+func TestCTLogWasApprovedAt(t *testing.T) {
+	retired := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	data := []struct {
+		state CTLogState
+		when  time.Time
+		want  bool
+	}{
+		{CTLogPending, retired, false},
+		{CTLogQualified, retired, true},
+		{CTLogUsable, retired, true},
+		{CTLogReadOnly, retired, true},
+		{CTLogRetired, retired.Add(-time.Second), true},
+		{CTLogRetired, retired, false},
+		{CTLogRejected, retired.Add(-time.Second), false},
+	}
+	for _, test := range data {
+		log := &CTLog{State: test.state, StateTimestamp: retired}
+		if got := log.WasApprovedAt(test.when); got != test.want {
+			t.Errorf("%s at %s: expected %v got %v", test.state, test.when, test.want, got)
+		}
+	}
+}
+
+func TestCTLogCovers(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	sharded := &CTLog{TemporalIntervalStart: start, TemporalIntervalEnd: end}
+	data := []struct {
+		notAfter time.Time
+		want     bool
+	}{
+		{start.Add(-time.Second), false},
+		{start, true},
+		{end.Add(-time.Second), true},
+		{end, false},
+	}
+	for _, test := range data {
+		if got := sharded.Covers(test.notAfter); got != test.want {
+			t.Errorf("%s: expected %v got %v", test.notAfter, test.want, got)
+		}
+	}
+	if !(&CTLog{}).Covers(end) {
+		t.Error("expected a log that is not sharded to cover any certificate")
+	}
+}
@@ -0,0 +1,534 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+// DERProblem describes a way in which an element of an encoding violates the
+// Distinguished Encoding Rules of X.690.
+type DERProblem struct {
+	// Path is the ASN.1 path of the offending element, for example
+	// "tbsCertificate.extensions[2.5.29.19].critical". Elements that have no
+	// name in RFC 5280 are identified by their zero based index within their
+	// parent, for example "tbsCertificate.subject[0][0]".
+	Path string
+	// Offset is the byte offset of the offending element within the encoding.
+	Offset int
+	// Description explains the problem.
+	Description string
+}
+
+func (p DERProblem) String() string {
+	return fmt.Sprintf("%s (offset %d): %s", p.Path, p.Offset, p.Description)
+}
+
+// CheckCertificateDER walks every element of the encoded certificate der,
+// including the values of its extensions, and returns each violation of DER
+// that it finds. It reports non-minimal lengths and tags, indefinite lengths,
+// BOOLEANs other than 0x00 and 0xFF, DEFAULT values that are encoded
+// explicitly, unsorted SET OF, non-minimal INTEGERs and BIT STRINGs with
+// invalid unused bits.
+//
+// Which values have a DEFAULT is only known for the fields of RFC 5280, so
+// DEFAULT values within the value of an unknown extension are not reported.
+func CheckCertificateDER(der []byte) []DERProblem {
+	return checkDER(der, "certificate", derCertificate)
+}
+
+// CheckRevocationListDER walks every element of the encoded revocation list
+// der, including the values of its extensions and those of its entries, and
+// returns each violation of DER that it finds. See CheckCertificateDER.
+func CheckRevocationListDER(der []byte) []DERProblem {
+	return checkDER(der, "certificateList", derCertificateList)
+}
+
+// derKind identifies the RFC 5280 type of an element, which determines the
+// names of its children and the DEFAULT values they may hold.
+type derKind int
+
+const (
+	derUnknown derKind = iota
+	derCertificate
+	derTBSCertificate
+	derVersion
+	derCertificateList
+	derTBSCertList
+	derRevokedCertificates
+	derRevokedCertificate
+	derExplicitExtensions
+	derExtensions
+	derExtension
+	derExtensionValue
+	derExtensionCritical
+	derKeyUsage
+	derBasicConstraints
+	derBasicConstraintsCA
+	derNameConstraints
+	derGeneralSubtrees
+	derGeneralSubtree
+	derIssuingDistributionPoint
+	derImplicitBooleanDefaultFalse
+	derImplicitIntegerDefaultZero
+)
+
+var (
+	tbsCertificateFields = []string{"serialNumber", "signature", "issuer", "validity", "subject", "subjectPublicKeyInfo"}
+	tbsCertListFields    = []string{"signature", "issuer", "thisUpdate"}
+)
+
+// derElement is a single decoded tag, length and value.
+type derElement struct {
+	offset      int
+	class       int
+	tag         int
+	constructed bool
+	content     []byte
+	// contentOffset is the offset of content within the walked encoding.
+	contentOffset int
+}
+
+type derWalker struct {
+	problems []DERProblem
+}
+
+func checkDER(der []byte, name string, kind derKind) []DERProblem {
+	w := &derWalker{}
+	e, rest, ok := w.read(der, 0, name)
+	if !ok {
+		return w.problems
+	}
+	if len(rest) != 0 {
+		w.report(len(der)-len(rest), name, "trailing data after the encoding")
+	}
+	w.check(e, name, kind)
+	return w.problems
+}
+
+func (w *derWalker) report(offset int, path string, format string, args ...interface{}) {
+	w.problems = append(w.problems, DERProblem{Path: path, Offset: offset, Description: fmt.Sprintf(format, args...)})
+}
+
+// read decodes the element at the start of in, which is found at offset base
+// within the walked encoding, reporting any problem with its tag or length.
+// The returned bool is false if the element can not be decoded, in which case
+// walking its siblings is impossible.
+func (w *derWalker) read(in []byte, base int, path string) (derElement, []byte, bool) {
+	e := derElement{offset: base}
+	if len(in) < 2 {
+		w.report(base, path, "truncated element")
+		return e, nil, false
+	}
+	e.class = int(in[0] >> 6)
+	e.constructed = in[0]&0x20 != 0
+	e.tag = int(in[0] & 0x1f)
+	pos := 1
+	if e.tag == 0x1f {
+		e.tag = 0
+		if in[pos] == 0x80 {
+			w.report(base, path, "tag number is not encoded in the minimum number of octets")
+		}
+		for {
+			if pos >= len(in) || pos > 4 {
+				w.report(base, path, "truncated or oversized tag number")
+				return e, nil, false
+			}
+			b := in[pos]
+			pos++
+			e.tag = e.tag<<7 | int(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		if e.tag < 0x1f {
+			w.report(base, path, "tag number %d must use the low tag number form", e.tag)
+		}
+	}
+	if pos >= len(in) {
+		w.report(base, path, "truncated element")
+		return e, nil, false
+	}
+	length := int(in[pos])
+	pos++
+	switch {
+	case length == 0x80:
+		w.report(base, path, "indefinite length is not allowed")
+		return e, nil, false
+	case length > 0x80:
+		n := length & 0x7f
+		if n > 4 || pos+n > len(in) {
+			w.report(base, path, "truncated or oversized length")
+			return e, nil, false
+		}
+		length = 0
+		for _, b := range in[pos : pos+n] {
+			length = length<<8 | int(b)
+		}
+		if in[pos] == 0 || length < 0x80 {
+			w.report(base, path, "length %d is not encoded in the minimum number of octets", length)
+		}
+		pos += n
+	}
+	if length > len(in)-pos {
+		w.report(base, path, "length %d exceeds the %d octets available", length, len(in)-pos)
+		return e, nil, false
+	}
+	e.content = in[pos : pos+length]
+	e.contentOffset = base + pos
+	return e, in[pos+length:], true
+}
+
+// check reports any problem with the encoding of e, whose path and kind are
+// given, and then walks its children.
+//
+//nolint:cyclop
+func (w *derWalker) check(e derElement, path string, kind derKind) {
+	if e.class == asn1.ClassUniversal {
+		switch {
+		case e.tag == asn1.TagSequence || e.tag == asn1.TagSet:
+			if !e.constructed {
+				w.report(e.offset, path, "SEQUENCE and SET must use the constructed form")
+				return
+			}
+		case e.constructed:
+			w.report(e.offset, path, "universal type %d must use the primitive form", e.tag)
+			return
+		}
+	}
+
+	switch {
+	case kind == derVersion && e.class == asn1.ClassUniversal && e.tag == asn1.TagInteger:
+		if len(e.content) == 1 && e.content[0] == 0 {
+			w.report(e.offset, path, "version v1 is the DEFAULT and must be omitted")
+		}
+	case kind == derImplicitBooleanDefaultFalse && !e.constructed:
+		w.checkBoolean(e, path, true)
+		return
+	case kind == derImplicitIntegerDefaultZero && !e.constructed:
+		w.checkInteger(e, path)
+		if len(e.content) == 1 && e.content[0] == 0 {
+			w.report(e.offset, path, "INTEGER is 0, which is the DEFAULT and must be omitted")
+		}
+		return
+	}
+
+	if e.class == asn1.ClassUniversal {
+		switch e.tag {
+		case asn1.TagBoolean:
+			w.checkBoolean(e, path, kind == derExtensionCritical || kind == derBasicConstraintsCA)
+		case asn1.TagInteger, asn1.TagEnum:
+			w.checkInteger(e, path)
+		case asn1.TagBitString:
+			w.checkBitString(e, path, kind == derKeyUsage)
+		case asn1.TagNull:
+			if len(e.content) != 0 {
+				w.report(e.offset, path, "NULL must have no contents octets")
+			}
+		case asn1.TagOID:
+			w.checkOID(e, path)
+		case asn1.TagOctetString:
+			if kind == derExtensionValue {
+				w.checkEncapsulated(e, path)
+			}
+		}
+	}
+	if !e.constructed {
+		return
+	}
+
+	var children []derElement
+	for in := e.content; len(in) > 0; {
+		childPath := path + fmt.Sprintf("[%d]", len(children))
+		child, rest, ok := w.read(in, e.contentOffset+len(e.content)-len(in), childPath)
+		if !ok {
+			return
+		}
+		children = append(children, child)
+		in = rest
+	}
+	universal := 0
+	for i, child := range children {
+		childPath, childKind := childName(path, kind, i, universal, children)
+		if child.class == asn1.ClassUniversal {
+			universal++
+		}
+		w.check(child, childPath, childKind)
+	}
+	if e.class == asn1.ClassUniversal && e.tag == asn1.TagSet {
+		w.checkSetOrder(e, path, children)
+	}
+}
+
+// checkBoolean reports a BOOLEAN that is not encoded as a single 0x00 or 0xFF
+// octet, and one that is FALSE if defaultFalse is set.
+func (w *derWalker) checkBoolean(e derElement, path string, defaultFalse bool) {
+	switch {
+	case len(e.content) != 1:
+		w.report(e.offset, path, "BOOLEAN must have exactly one contents octet")
+	case e.content[0] == 0x00:
+		if defaultFalse {
+			w.report(e.offset, path, "BOOLEAN is FALSE, which is the DEFAULT and must be omitted")
+		}
+	case e.content[0] != 0xff:
+		w.report(e.offset, path, "BOOLEAN TRUE must be encoded as 0xFF, not 0x%02X", e.content[0])
+	}
+}
+
+func (w *derWalker) checkInteger(e derElement, path string) {
+	c := e.content
+	switch {
+	case len(c) == 0:
+		w.report(e.offset, path, "INTEGER must have at least one contents octet")
+	case len(c) > 1 && ((c[0] == 0x00 && c[1]&0x80 == 0) || (c[0] == 0xff && c[1]&0x80 != 0)):
+		w.report(e.offset, path, "INTEGER is not encoded in the minimum number of octets")
+	}
+}
+
+// checkBitString reports invalid unused bits. The trailing zero bits of a
+// named bit list, such as a KeyUsage, must also be removed.
+func (w *derWalker) checkBitString(e derElement, path string, namedBitList bool) {
+	c := e.content
+	if len(c) == 0 {
+		w.report(e.offset, path, "BIT STRING must have an initial octet")
+		return
+	}
+	unused := c[0]
+	switch {
+	case unused > 7:
+		w.report(e.offset, path, "BIT STRING has %d unused bits, which is more than 7", unused)
+	case len(c) == 1 && unused != 0:
+		w.report(e.offset, path, "empty BIT STRING has %d unused bits, rather than 0", unused)
+	case len(c) > 1 && c[len(c)-1]&(1<<unused-1) != 0:
+		w.report(e.offset, path, "BIT STRING has unused bits that are not zero")
+	case namedBitList && len(c) > 1 && c[len(c)-1]&(1<<unused) == 0:
+		w.report(e.offset, path, "named bit list BIT STRING has trailing zero bits")
+	}
+}
+
+func (w *derWalker) checkOID(e derElement, path string) {
+	c := e.content
+	if len(c) == 0 {
+		w.report(e.offset, path, "OBJECT IDENTIFIER must have at least one contents octet")
+		return
+	}
+	if c[len(c)-1]&0x80 != 0 {
+		w.report(e.offset, path, "OBJECT IDENTIFIER ends within a subidentifier")
+	}
+	start := true
+	for _, b := range c {
+		if start && b == 0x80 {
+			w.report(e.offset, path, "OBJECT IDENTIFIER subidentifier is not encoded in the minimum number of octets")
+			return
+		}
+		start = b&0x80 == 0
+	}
+}
+
+// checkEncapsulated walks the DER encoded value held by the extnValue OCTET
+// STRING e of the extension at path.
+func (w *derWalker) checkEncapsulated(e derElement, path string) {
+	kind := derUnknown
+	switch {
+	case strings.HasSuffix(path, "[2.5.29.15].extnValue"):
+		kind = derKeyUsage
+	case strings.HasSuffix(path, "[2.5.29.19].extnValue"):
+		kind = derBasicConstraints
+	case strings.HasSuffix(path, "[2.5.29.30].extnValue"):
+		kind = derNameConstraints
+	case strings.HasSuffix(path, "[2.5.29.28].extnValue"):
+		kind = derIssuingDistributionPoint
+	}
+	value, rest, ok := w.read(e.content, e.contentOffset, path)
+	if !ok {
+		return
+	}
+	if len(rest) != 0 {
+		w.report(e.contentOffset+len(e.content)-len(rest), path, "trailing data after the extension value")
+	}
+	w.check(value, path, kind)
+}
+
+// checkSetOrder reports a SET whose elements are not in ascending order of
+// their encodings, as X.690 11.6 requires of a SET OF.
+func (w *derWalker) checkSetOrder(e derElement, path string, children []derElement) {
+	encoding := func(child derElement) []byte {
+		start := child.offset - e.contentOffset
+		end := child.contentOffset - e.contentOffset + len(child.content)
+		return e.content[start:end]
+	}
+	for i := 1; i < len(children); i++ {
+		previous, current := encoding(children[i-1]), encoding(children[i])
+		// Shorter encodings are compared as if padded with trailing zeros.
+		n := len(previous)
+		if len(current) < n {
+			n = len(current)
+		}
+		cmp := bytes.Compare(previous[:n], current[:n])
+		if cmp > 0 || (cmp == 0 && len(previous) > len(current) && !isZero(previous[n:])) {
+			w.report(children[i].offset, path, "SET OF elements are not sorted by their encodings")
+			return
+		}
+	}
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// childName returns the path and kind of the ith of the children of the
+// element at path of the given kind. universal is the number of children of
+// the universal class preceding the ith.
+//
+//nolint:cyclop
+func childName(path string, kind derKind, i int, universal int, children []derElement) (string, derKind) {
+	child := children[i]
+	named := func(name string) string {
+		if kind == derCertificate || kind == derCertificateList {
+			return name
+		}
+		return path + "." + name
+	}
+	contextTag := -1
+	if child.class == asn1.ClassContextSpecific {
+		contextTag = child.tag
+	}
+	switch kind {
+	case derCertificate, derCertificateList:
+		tbs, tbsKind := "tbsCertificate", derTBSCertificate
+		if kind == derCertificateList {
+			tbs, tbsKind = "tbsCertList", derTBSCertList
+		}
+		switch i {
+		case 0:
+			return named(tbs), tbsKind
+		case 1:
+			return named("signatureAlgorithm"), derUnknown
+		case 2:
+			return named("signatureValue"), derUnknown
+		}
+	case derTBSCertificate:
+		switch {
+		case contextTag == 0:
+			return named("version"), derVersion
+		case contextTag == 1:
+			return named("issuerUniqueID"), derUnknown
+		case contextTag == 2:
+			return named("subjectUniqueID"), derUnknown
+		case contextTag == 3:
+			return named("extensions"), derExplicitExtensions
+		case contextTag < 0 && universal < len(tbsCertificateFields):
+			return named(tbsCertificateFields[universal]), derUnknown
+		}
+	case derTBSCertList:
+		// Unlike that of a TBSCertificate, the optional version of
+		// a TBSCertList is not tagged.
+		first := children[0]
+		hasVersion := first.class == asn1.ClassUniversal && first.tag == asn1.TagInteger
+		index := universal
+		if hasVersion {
+			index--
+		}
+		switch {
+		case contextTag == 0:
+			return named("crlExtensions"), derExplicitExtensions
+		case contextTag >= 0:
+		case index < 0:
+			return named("version"), derUnknown
+		case index < len(tbsCertListFields):
+			return named(tbsCertListFields[index]), derUnknown
+		case child.tag == asn1.TagUTCTime || child.tag == asn1.TagGeneralizedTime:
+			return named("nextUpdate"), derUnknown
+		case child.tag == asn1.TagSequence:
+			return named("revokedCertificates"), derRevokedCertificates
+		}
+	case derRevokedCertificates:
+		return fmt.Sprintf("%s[%d]", path, i), derRevokedCertificate
+	case derRevokedCertificate:
+		switch universal {
+		case 0:
+			return named("userCertificate"), derUnknown
+		case 1:
+			return named("revocationDate"), derUnknown
+		case 2:
+			return named("crlEntryExtensions"), derExtensions
+		}
+	case derExplicitExtensions:
+		return path, derExtensions
+	case derExtensions:
+		var oid asn1.ObjectIdentifier
+		if _, err := asn1.Unmarshal(child.content, &oid); err == nil {
+			return fmt.Sprintf("%s[%s]", path, oid), derExtension
+		}
+	case derExtension:
+		switch child.tag {
+		case asn1.TagOID:
+			return named("extnID"), derUnknown
+		case asn1.TagBoolean:
+			return named("critical"), derExtensionCritical
+		case asn1.TagOctetString:
+			return named("extnValue"), derExtensionValue
+		}
+	case derVersion:
+		return path, derVersion
+	case derBasicConstraints:
+		switch {
+		case contextTag >= 0:
+		case child.tag == asn1.TagBoolean:
+			return named("cA"), derBasicConstraintsCA
+		case child.tag == asn1.TagInteger:
+			return named("pathLenConstraint"), derUnknown
+		}
+	case derNameConstraints:
+		switch contextTag {
+		case 0:
+			return named("permittedSubtrees"), derGeneralSubtrees
+		case 1:
+			return named("excludedSubtrees"), derGeneralSubtrees
+		}
+	case derGeneralSubtrees:
+		return fmt.Sprintf("%s[%d]", path, i), derGeneralSubtree
+	case derGeneralSubtree:
+		// The base GeneralName may itself be context specific, so it is
+		// identified by its position. The minimum is an implicitly tagged
+		// BaseDistance with a DEFAULT of 0.
+		switch {
+		case i == 0:
+			return named("base"), derUnknown
+		case contextTag == 0:
+			return named("minimum"), derImplicitIntegerDefaultZero
+		case contextTag == 1:
+			return named("maximum"), derUnknown
+		}
+	case derIssuingDistributionPoint:
+		// onlyContainsUserCerts, onlyContainsCACerts, indirectCRL and
+		// onlyContainsAttributeCerts are implicitly tagged BOOLEANs with a
+		// DEFAULT of FALSE.
+		switch contextTag {
+		case 1, 2, 4, 5:
+			return fmt.Sprintf("%s[%d]", path, i), derImplicitBooleanDefaultFalse
+		}
+	}
+	return fmt.Sprintf("%s[%d]", path, i), derUnknown
+}
@@ -0,0 +1,201 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+func TestCheckDER(t *testing.T) {
+	integer := func(content ...byte) []byte {
+		return rawTestElement(asn1.ClassUniversal, asn1.TagInteger, false, content)
+	}
+	boolean := func(value byte) []byte {
+		return rawTestElement(asn1.ClassUniversal, asn1.TagBoolean, false, []byte{value})
+	}
+	oid := func(content ...byte) []byte {
+		return rawTestElement(asn1.ClassUniversal, asn1.TagOID, false, content)
+	}
+	bitString := func(content ...byte) []byte {
+		return rawTestElement(asn1.ClassUniversal, asn1.TagBitString, false, content)
+	}
+	set := func(content ...[]byte) []byte {
+		return rawTestElement(asn1.ClassUniversal, asn1.TagSet, true, content...)
+	}
+	extension := func(id []byte, value []byte, critical ...[]byte) []byte {
+		elements := append([][]byte{id}, critical...)
+		elements = append(elements, rawTestElement(asn1.ClassUniversal, asn1.TagOctetString, false, value))
+		return rawTestSequence(elements...)
+	}
+	keyUsage := oid(0x55, 0x1d, 0x0f)
+	basicConstraints := oid(0x55, 0x1d, 0x13)
+	nameConstraints := oid(0x55, 0x1d, 0x1e)
+	subtree := func(distances ...[]byte) []byte {
+		base := rawTestElement(asn1.ClassContextSpecific, 2, false, []byte("example.com"))
+		return rawTestSequence(append([][]byte{base}, distances...)...)
+	}
+	distance := func(tag int, value byte) []byte {
+		return rawTestElement(asn1.ClassContextSpecific, tag, false, []byte{value})
+	}
+	time := rawTestElement(asn1.ClassUniversal, asn1.TagUTCTime, false, []byte("240101000000Z"))
+	algorithm := rawTestSequence(oid(0x2a, 0x03))
+	attribute := func(value string) []byte {
+		return rawTestSequence(oid(0x55, 0x04, 0x03), rawTestElement(asn1.ClassUniversal, asn1.TagUTF8String, false, []byte(value)))
+	}
+	name := rawTestSequence(set(attribute("a"), attribute("b")))
+	certificate := func(version, serial, subject, extensions, signature []byte) []byte {
+		tbs := rawTestSequence(
+			version,
+			serial,
+			algorithm,
+			name,
+			rawTestSequence(time, time),
+			subject,
+			rawTestSequence(),
+			rawTestElement(asn1.ClassContextSpecific, 3, true, extensions),
+		)
+		return rawTestSequence(tbs, algorithm, signature)
+	}
+	v3 := rawTestElement(asn1.ClassContextSpecific, 0, true, integer(2))
+	extensions := rawTestSequence(
+		extension(keyUsage, bitString(5, 0xa0), boolean(0xff)),
+		extension(basicConstraints, rawTestSequence(boolean(0xff))),
+	)
+	signature := bitString(0, 0xff)
+
+	if problems := CheckCertificateDER(certificate(v3, integer(1), name, extensions, signature)); len(problems) != 0 {
+		t.Fatalf("expected no problems, got %v", problems)
+	}
+
+	testCases := []struct {
+		name     string
+		der      []byte
+		expected []DERProblem
+	}{
+		{
+			name: "non-minimal length",
+			der:  append([]byte{0x30, 0x81, 0x03}, integer(1)...),
+			expected: []DERProblem{
+				{Path: "certificate", Offset: 0, Description: "length 3 is not encoded in the minimum number of octets"},
+			},
+		},
+		{
+			name: "explicit v1",
+			der:  certificate(rawTestElement(asn1.ClassContextSpecific, 0, true, integer(0)), integer(1), name, extensions, signature),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.version", Offset: 8, Description: "version v1 is the DEFAULT and must be omitted"},
+			},
+		},
+		{
+			name: "non-minimal INTEGER",
+			der:  certificate(v3, integer(0, 1), name, extensions, signature),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.serialNumber", Offset: 11, Description: "INTEGER is not encoded in the minimum number of octets"},
+			},
+		},
+		{
+			name: "unsorted SET OF",
+			der:  certificate(v3, integer(1), rawTestSequence(set(attribute("b"), attribute("a"))), extensions, signature),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.subject[0]", Offset: 90, Description: "SET OF elements are not sorted by their encodings"},
+			},
+		},
+		{
+			name: "BOOLEANs",
+			der: certificate(v3, integer(1), name, rawTestSequence(
+				extension(keyUsage, bitString(5, 0xa0), boolean(0x01)),
+				extension(basicConstraints, rawTestSequence(boolean(0x00)), boolean(0x00)),
+			), signature),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.extensions[2.5.29.15].critical", Offset: 113, Description: "BOOLEAN TRUE must be encoded as 0xFF, not 0x01"},
+				{Path: "tbsCertificate.extensions[2.5.29.19].critical", Offset: 129, Description: "BOOLEAN is FALSE, which is the DEFAULT and must be omitted"},
+				{Path: "tbsCertificate.extensions[2.5.29.19].extnValue.cA", Offset: 136, Description: "BOOLEAN is FALSE, which is the DEFAULT and must be omitted"},
+			},
+		},
+		{
+			name: "GeneralSubtree minimum",
+			der: certificate(v3, integer(1), name, rawTestSequence(
+				extension(nameConstraints, rawTestSequence(
+					rawTestElement(asn1.ClassContextSpecific, 0, true, subtree(distance(0, 0x00))),
+					rawTestElement(asn1.ClassContextSpecific, 1, true, subtree(distance(0, 0x01), distance(1, 0x02)), subtree(distance(1, 0x00))),
+				)),
+			), signature),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.extensions[2.5.29.30].extnValue.permittedSubtrees[0].minimum", Offset: 134, Description: "INTEGER is 0, which is the DEFAULT and must be omitted"},
+			},
+		},
+		{
+			name: "BIT STRINGs",
+			der: certificate(v3, integer(1), name, rawTestSequence(
+				extension(keyUsage, bitString(4, 0xa0), boolean(0xff)),
+			), bitString(1, 0xff)),
+			expected: []DERProblem{
+				{Path: "tbsCertificate.extensions[2.5.29.15].extnValue", Offset: 117, Description: "named bit list BIT STRING has trailing zero bits"},
+				{Path: "signatureValue", Offset: 127, Description: "BIT STRING has unused bits that are not zero"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if problems := CheckCertificateDER(tc.der); !reflect.DeepEqual(problems, tc.expected) {
+				t.Errorf("expected problems %v, got %v", tc.expected, problems)
+			}
+		})
+	}
+}
+
+func TestCheckRevocationListDER(t *testing.T) {
+	algorithm := rawTestSequence(rawTestElement(asn1.ClassUniversal, asn1.TagOID, false, []byte{0x2a, 0x03}))
+	time := rawTestElement(asn1.ClassUniversal, asn1.TagUTCTime, false, []byte("240101000000Z"))
+	reasonCode := rawTestSequence(
+		rawTestElement(asn1.ClassUniversal, asn1.TagOID, false, []byte{0x55, 0x1d, 0x15}),
+		rawTestElement(asn1.ClassUniversal, asn1.TagBoolean, false, []byte{0x00}),
+		rawTestElement(asn1.ClassUniversal, asn1.TagOctetString, false, []byte{asn1.TagEnum, 1, 1}),
+	)
+	idp := rawTestSequence(
+		rawTestElement(asn1.ClassUniversal, asn1.TagOID, false, []byte{0x55, 0x1d, 0x1c}),
+		rawTestElement(asn1.ClassUniversal, asn1.TagOctetString, false,
+			rawTestSequence(rawTestElement(asn1.ClassContextSpecific, 1, false, []byte{0x00}))),
+	)
+	tbs := rawTestSequence(
+		rawTestElement(asn1.ClassUniversal, asn1.TagInteger, false, []byte{1}),
+		algorithm,
+		rawTestSequence(),
+		time,
+		time,
+		rawTestSequence(rawTestSequence(
+			rawTestElement(asn1.ClassUniversal, asn1.TagInteger, false, []byte{1}),
+			time,
+			rawTestSequence(reasonCode),
+		)),
+		rawTestElement(asn1.ClassContextSpecific, 0, true, rawTestSequence(idp)),
+	)
+	der := rawTestSequence(tbs, algorithm, rawTestElement(asn1.ClassUniversal, asn1.TagBitString, false, []byte{0}))
+
+	var paths []string
+	for _, p := range CheckRevocationListDER(der) {
+		paths = append(paths, p.Path)
+	}
+	expected := []string{
+		"tbsCertList.revokedCertificates[0].crlEntryExtensions[2.5.29.21].critical",
+		"tbsCertList.crlExtensions[2.5.29.28].extnValue[0]",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected problems at %v, got %v", expected, paths)
+	}
+}
@@ -0,0 +1,402 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+
+	"github.com/zmap/zcrypto/cryptobyte"
+	cryptobyte_asn1 "github.com/zmap/zcrypto/cryptobyte/asn1"
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+var (
+	oidPublicKeyEC      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPrimeField       = asn1.ObjectIdentifier{1, 2, 840, 10045, 1, 1}
+	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidPublicKeyEd448   = asn1.ObjectIdentifier{1, 3, 101, 113}
+)
+
+// nistCurve is one of the NIST prime curves whose public keys are validated
+// by ValidateECPublicKey.
+type nistCurve struct {
+	oid    asn1.ObjectIdentifier
+	params *elliptic.CurveParams
+}
+
+var nistCurves = []nistCurve{
+	{asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}, elliptic.P256().Params()},
+	{asn1.ObjectIdentifier{1, 3, 132, 0, 34}, elliptic.P384().Params()},
+	{asn1.ObjectIdentifier{1, 3, 132, 0, 35}, elliptic.P521().Params()},
+}
+
+// edwardsCurve is one of the Edwards curves of RFC 8032,
+//
+//	a*x^2 + y^2 = 1 + d*x^2*y^2
+//
+// whose EdDSA public keys are validated by ValidateECPublicKey.
+type edwardsCurve struct {
+	name string
+	p    *big.Int
+	a    *big.Int
+	d    *big.Int
+	// order is the order of the prime order subgroup of the curve.
+	order *big.Int
+	// size is the size of an encoded point.
+	size int
+}
+
+var (
+	ed25519Curve = func() *edwardsCurve {
+		p := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+		// d = -121665/121666
+		d := new(big.Int).ModInverse(big.NewInt(121666), p)
+		d.Mul(d, big.NewInt(-121665)).Mod(d, p)
+		order, _ := new(big.Int).SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+		return &edwardsCurve{name: "Ed25519", p: p, a: big.NewInt(-1), d: d, order: order, size: 32}
+	}()
+	ed448Curve = func() *edwardsCurve {
+		p := new(big.Int).Lsh(big.NewInt(1), 448)
+		p.Sub(p, new(big.Int).Lsh(big.NewInt(1), 224)).Sub(p, big.NewInt(1))
+		d := new(big.Int).Mod(big.NewInt(-39081), p)
+		order, _ := new(big.Int).SetString("181709681073901722637330951972001133588410340171829515070372549795146003961539585716195755291692375963310293709091662304773755859649779", 10)
+		return &edwardsCurve{name: "Ed448", p: p, a: big.NewInt(1), d: d, order: order, size: 57}
+	}()
+)
+
+// ecPublicKey is an elliptic curve public key read from a SubjectPublicKeyInfo.
+// Exactly one of nist and edwards is set.
+type ecPublicKey struct {
+	nist    *elliptic.CurveParams
+	edwards *edwardsCurve
+	// point is the encoded point of the subjectPublicKey.
+	point []byte
+	// problems are those found in the encoding of the SubjectPublicKeyInfo.
+	problems []string
+}
+
+func (k *ecPublicKey) curve() string {
+	if k.nist != nil {
+		return k.nist.Name
+	}
+	return k.edwards.name
+}
+
+func (k *ecPublicKey) addProblem(format string, args ...interface{}) {
+	k.problems = append(k.problems, fmt.Sprintf(format, args...))
+}
+
+// ECPublicKeyCurve returns the name of the curve of the elliptic curve public
+// key in the DER encoded SubjectPublicKeyInfo spki, which is one of P-256,
+// P-384 or P-521, given by name or by explicit parameters, Ed25519 or Ed448.
+// The empty string is returned if spki is not a public key on one of those
+// curves.
+func ECPublicKeyCurve(spki []byte) string {
+	key := parseECPublicKey(spki)
+	if key == nil {
+		return ""
+	}
+	return key.curve()
+}
+
+// ValidateECPublicKey performs full public key validation of the elliptic
+// curve public key in the DER encoded SubjectPublicKeyInfo spki, and returns a
+// description of each problem found. No problems are returned for a valid key.
+// The key must be on one of the curves named by ECPublicKeyCurve.
+//
+// Keys on the NIST curves are validated as described by NIST SP 800-56A rev. 3,
+// section 5.6.2.3.3: the point must not be the point at infinity, its
+// coordinates must be elements of the field, and it must be on the curve.
+// Compressed points are decompressed in order to be validated, and explicit
+// curve parameters must be those of the curve, but both are reported as
+// problems. EdDSA keys are decoded as described by RFC 8032, sections 5.1.3
+// and 5.2.3, and must not be the neutral element and must be in the subgroup
+// of prime order, as described by NIST SP 800-186, appendix D.1.3.
+func ValidateECPublicKey(spki []byte) []string {
+	key := parseECPublicKey(spki)
+	if key == nil {
+		return []string{"the public key is not on a supported elliptic curve"}
+	}
+	if key.nist != nil {
+		validateNISTPoint(key)
+	} else {
+		validateEdwardsPoint(key)
+	}
+	return key.problems
+}
+
+// parseECPublicKey reads the elliptic curve public key in spki, or returns nil
+// if spki is not a public key on a supported curve.
+func parseECPublicKey(spki []byte) *ecPublicKey {
+	input := cryptobyte.String(spki)
+	var info, algorithm cryptobyte.String
+	var oid asn1.ObjectIdentifier
+	var point asn1.BitString
+	if !input.ReadASN1(&info, cryptobyte_asn1.SEQUENCE) ||
+		!info.ReadASN1(&algorithm, cryptobyte_asn1.SEQUENCE) ||
+		!algorithm.ReadASN1ObjectIdentifier(&oid) ||
+		!info.ReadASN1BitString(&point) {
+		return nil
+	}
+	key := &ecPublicKey{point: point.Bytes}
+	if point.BitLength%8 != 0 {
+		key.addProblem("the subjectPublicKey BIT STRING is not a whole number of octets")
+	}
+	switch {
+	case oid.Equal(oidPublicKeyEd25519), oid.Equal(oidPublicKeyEd448):
+		key.edwards = ed25519Curve
+		if oid.Equal(oidPublicKeyEd448) {
+			key.edwards = ed448Curve
+		}
+		if !algorithm.Empty() {
+			key.addProblem("the %s AlgorithmIdentifier has parameters, which must be absent", key.edwards.name)
+		}
+	case oid.Equal(oidPublicKeyEC):
+		switch {
+		case algorithm.PeekASN1Tag(cryptobyte_asn1.OBJECT_IDENTIFIER):
+			var curveOID asn1.ObjectIdentifier
+			algorithm.ReadASN1ObjectIdentifier(&curveOID)
+			for _, curve := range nistCurves {
+				if curve.oid.Equal(curveOID) {
+					key.nist = curve.params
+				}
+			}
+		case algorithm.PeekASN1Tag(cryptobyte_asn1.SEQUENCE):
+			var params cryptobyte.String
+			algorithm.ReadASN1(&params, cryptobyte_asn1.SEQUENCE)
+			key.nist = matchExplicitParameters(params)
+			if key.nist != nil {
+				key.addProblem("the curve is given by explicit parameters, rather than by the named curve %s", key.nist.Name)
+			}
+		}
+		if key.nist == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return key
+}
+
+// matchExplicitParameters returns the NIST curve whose domain parameters are
+// given by the SpecifiedECDomain params, or nil if they are not those of a
+// NIST curve.
+func matchExplicitParameters(params cryptobyte.String) *elliptic.CurveParams {
+	var version int
+	var fieldID, curve cryptobyte.String
+	var fieldType asn1.ObjectIdentifier
+	var a, b, base []byte
+	prime, order, cofactor := new(big.Int), new(big.Int), big.NewInt(1)
+	if !params.ReadASN1Integer(&version) || version < 1 || version > 3 ||
+		!params.ReadASN1(&fieldID, cryptobyte_asn1.SEQUENCE) ||
+		!fieldID.ReadASN1ObjectIdentifier(&fieldType) || !fieldType.Equal(oidPrimeField) ||
+		!fieldID.ReadASN1Integer(prime) ||
+		!params.ReadASN1(&curve, cryptobyte_asn1.SEQUENCE) ||
+		!curve.ReadASN1Bytes(&a, cryptobyte_asn1.OCTET_STRING) ||
+		!curve.ReadASN1Bytes(&b, cryptobyte_asn1.OCTET_STRING) ||
+		!params.ReadASN1Bytes(&base, cryptobyte_asn1.OCTET_STRING) ||
+		!params.ReadASN1Integer(order) {
+		return nil
+	}
+	if params.PeekASN1Tag(cryptobyte_asn1.INTEGER) && !params.ReadASN1Integer(cofactor) {
+		return nil
+	}
+	for _, c := range nistCurves {
+		curve := c.params
+		// The NIST curves have a = -3.
+		minusThree := new(big.Int).Sub(curve.P, big.NewInt(3))
+		if prime.Cmp(curve.P) != 0 ||
+			new(big.Int).SetBytes(a).Cmp(minusThree) != 0 ||
+			new(big.Int).SetBytes(b).Cmp(curve.B) != 0 ||
+			order.Cmp(curve.N) != 0 ||
+			cofactor.Cmp(big.NewInt(1)) != 0 {
+			continue
+		}
+		generator := &ecPublicKey{nist: curve, point: base}
+		x, y := decodeNISTPoint(generator)
+		if x != nil && x.Cmp(curve.Gx) == 0 && y.Cmp(curve.Gy) == 0 {
+			return curve
+		}
+	}
+	return nil
+}
+
+// validateNISTPoint validates the point of a public key on a NIST curve.
+func validateNISTPoint(key *ecPublicKey) {
+	x, y := decodeNISTPoint(key)
+	if x == nil {
+		return
+	}
+	curve := key.nist
+	if x.Cmp(curve.P) >= 0 || y.Cmp(curve.P) >= 0 {
+		key.addProblem("the coordinates of the point are not elements of the field of %s", curve.Name)
+		return
+	}
+	if !isOnNISTCurve(curve, x, y) {
+		key.addProblem("the point is not on the curve %s", curve.Name)
+	}
+	// The NIST curves have a cofactor of one, so every point on the curve
+	// other than the point at infinity has the order of the curve, and the
+	// check that n*Q is the point at infinity is implied.
+}
+
+// decodeNISTPoint decodes the uncompressed or compressed point of key, adding
+// a problem and returning nil if that is not possible.
+func decodeNISTPoint(key *ecPublicKey) (*big.Int, *big.Int) {
+	curve, point := key.nist, key.point
+	size := (curve.BitSize + 7) / 8
+	switch {
+	case len(point) == 1 && point[0] == 0:
+		key.addProblem("the point is the point at infinity")
+		return nil, nil
+	case len(point) == 1+2*size && point[0] == 4:
+		return new(big.Int).SetBytes(point[1 : 1+size]), new(big.Int).SetBytes(point[1+size:])
+	case len(point) == 1+size && (point[0] == 2 || point[0] == 3):
+		key.addProblem("the point is compressed, rather than in the uncompressed form")
+		x := new(big.Int).SetBytes(point[1:])
+		if x.Cmp(curve.P) >= 0 {
+			key.addProblem("the coordinates of the point are not elements of the field of %s", curve.Name)
+			return nil, nil
+		}
+		y := new(big.Int).ModSqrt(nistCurveRHS(curve, x), curve.P)
+		if y == nil {
+			key.addProblem("the point is not on the curve %s", curve.Name)
+			return nil, nil
+		}
+		if y.Bit(0) != uint(point[0]&1) {
+			y.Sub(curve.P, y)
+		}
+		return x, y
+	default:
+		key.addProblem("the point is not a valid encoding of a point on %s", curve.Name)
+		return nil, nil
+	}
+}
+
+// nistCurveRHS returns x^3 - 3x + b.
+func nistCurveRHS(curve *elliptic.CurveParams, x *big.Int) *big.Int {
+	rhs := new(big.Int).Mul(x, x)
+	rhs.Mul(rhs, x)
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+	rhs.Sub(rhs, threeX)
+	rhs.Add(rhs, curve.B)
+	return rhs.Mod(rhs, curve.P)
+}
+
+func isOnNISTCurve(curve *elliptic.CurveParams, x, y *big.Int) bool {
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, curve.P)
+	return y2.Cmp(nistCurveRHS(curve, x)) == 0
+}
+
+// validateEdwardsPoint validates the point of an EdDSA public key.
+func validateEdwardsPoint(key *ecPublicKey) {
+	curve, point := key.edwards, key.point
+	if len(point) != curve.size {
+		key.addProblem("the %s public key is %d octets long, rather than %d", curve.name, len(point), curve.size)
+		return
+	}
+	// The point is encoded as the little endian y coordinate, with the most
+	// significant bit of the final octet holding the least significant bit of
+	// the x coordinate.
+	encoded := make([]byte, len(point))
+	for i, b := range point {
+		encoded[len(point)-1-i] = b
+	}
+	xBit := uint(encoded[0] >> 7)
+	encoded[0] &= 0x7f
+	y := new(big.Int).SetBytes(encoded)
+	if y.Cmp(curve.p) >= 0 {
+		key.addProblem("the y coordinate of the point is not an element of the field of %s", curve.name)
+		return
+	}
+	// x^2 = (y^2 - 1) / (d*y^2 - a)
+	y2 := new(big.Int).Mul(y, y)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	v := new(big.Int).Mul(curve.d, y2)
+	v.Sub(v, curve.a).Mod(v, curve.p)
+	x := new(big.Int).ModInverse(v, curve.p)
+	if x != nil {
+		x.Mul(x, u).Mod(x, curve.p)
+		x = x.ModSqrt(x, curve.p)
+	}
+	if x == nil || (x.Sign() == 0 && xBit == 1) {
+		key.addProblem("the point is not on the curve %s", curve.name)
+		return
+	}
+	if x.Bit(0) != xBit {
+		x.Sub(curve.p, x)
+	}
+	q := edwardsPoint{x: x, y: y, z: big.NewInt(1)}
+	if curve.isNeutral(q) {
+		key.addProblem("the point is the neutral element")
+		return
+	}
+	if !curve.isNeutral(curve.scalarMult(q, curve.order)) {
+		key.addProblem("the point is not in the subgroup of prime order of %s", curve.name)
+	}
+}
+
+// edwardsPoint is a point on an Edwards curve in projective coordinates
+// (X : Y : Z), where x = X/Z and y = Y/Z.
+type edwardsPoint struct {
+	x, y, z *big.Int
+}
+
+// add returns p1 + p2, using the addition formulas of Bernstein et al.,
+// which are complete for the curves of RFC 8032 and so may also be used for
+// doubling.
+func (c *edwardsCurve) add(p1, p2 edwardsPoint) edwardsPoint {
+	mul := func(a, b *big.Int) *big.Int {
+		r := new(big.Int).Mul(a, b)
+		return r.Mod(r, c.p)
+	}
+	A := mul(p1.z, p2.z)
+	B := mul(A, A)
+	C := mul(p1.x, p2.x)
+	D := mul(p1.y, p2.y)
+	E := mul(c.d, mul(C, D))
+	F := new(big.Int).Sub(B, E)
+	G := new(big.Int).Add(B, E)
+	H := mul(new(big.Int).Add(p1.x, p1.y), new(big.Int).Add(p2.x, p2.y))
+	H.Sub(H, C).Sub(H, D)
+	I := new(big.Int).Sub(D, mul(c.a, C))
+	return edwardsPoint{
+		x: mul(mul(A, F), H),
+		y: mul(mul(A, G), I),
+		z: mul(F, G),
+	}
+}
+
+// scalarMult returns k*q.
+func (c *edwardsCurve) scalarMult(q edwardsPoint, k *big.Int) edwardsPoint {
+	r := edwardsPoint{x: big.NewInt(0), y: big.NewInt(1), z: big.NewInt(1)}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		r = c.add(r, r)
+		if k.Bit(i) == 1 {
+			r = c.add(r, q)
+		}
+	}
+	return r
+}
+
+// isNeutral reports whether q is the neutral element (0, 1).
+func (c *edwardsCurve) isNeutral(q edwardsPoint) bool {
+	y := new(big.Int).Sub(q.y, q.z)
+	return new(big.Int).Mod(q.x, c.p).Sign() == 0 && y.Mod(y, c.p).Sign() == 0 && q.z.Sign() != 0
+}
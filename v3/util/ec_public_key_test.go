@@ -0,0 +1,301 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+type testSPKI struct {
+	Algorithm testAlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type testAlgorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type testECParameters struct {
+	Version  int
+	FieldID  testFieldID
+	Curve    testCurve
+	Base     []byte
+	Order    *big.Int
+	Cofactor int `asn1:"optional"`
+}
+
+type testFieldID struct {
+	FieldType asn1.ObjectIdentifier
+	Prime     *big.Int
+}
+
+type testCurve struct {
+	A, B []byte
+}
+
+func marshalTestSPKI(t *testing.T, oid asn1.ObjectIdentifier, params interface{}, point []byte) []byte {
+	t.Helper()
+	spki := testSPKI{
+		Algorithm: testAlgorithmIdentifier{Algorithm: oid},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	}
+	if params != nil {
+		der, err := asn1.Marshal(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spki.Algorithm.Parameters = asn1.RawValue{FullBytes: der}
+	}
+	der, err := asn1.Marshal(spki)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+// encodeEdwardsPoint encodes the point with the y coordinate y and the least
+// significant bit of its x coordinate xBit as described by RFC 8032.
+func encodeEdwardsPoint(curve *edwardsCurve, y *big.Int, xBit uint) []byte {
+	encoded := make([]byte, curve.size)
+	y.FillBytes(encoded)
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	encoded[curve.size-1] |= byte(xBit << 7)
+	return encoded
+}
+
+func TestValidateECPublicKey(t *testing.T) {
+	p256 := elliptic.P256()
+	key, err := ecdsa.GenerateKey(p256, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncompressed := elliptic.Marshal(p256, key.X, key.Y) //nolint:staticcheck
+	valid, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validP384, err := x509.MarshalPKIXPublicKey(&p384Key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p521Key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validP521, err := x509.MarshalPKIXPublicKey(&p521Key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	validEd25519, err := x509.MarshalPKIXPublicKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p256OID := nistCurves[0].oid
+	params := p256.Params()
+	offCurve := append([]byte{}, uncompressed...)
+	offCurve[len(offCurve)-1] ^= 1
+	outOfRange := append([]byte{4}, params.P.Bytes()...)
+	outOfRange = append(outOfRange, uncompressed[33:]...)
+	explicit := testECParameters{
+		Version: 1,
+		FieldID: testFieldID{FieldType: oidPrimeField, Prime: params.P},
+		Curve: testCurve{
+			A: new(big.Int).Sub(params.P, big.NewInt(3)).Bytes(),
+			B: params.B.Bytes(),
+		},
+		Base:     elliptic.Marshal(p256, params.Gx, params.Gy), //nolint:staticcheck
+		Order:    params.N,
+		Cofactor: 1,
+	}
+	wrongGenerator := explicit
+	wrongGenerator.Base = elliptic.Marshal(p256, key.X, key.Y) //nolint:staticcheck
+
+	ed25519Minus1 := new(big.Int).Sub(ed25519Curve.p, big.NewInt(1))
+	ed448Gy, _ := new(big.Int).SetString("298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660", 10)
+	ed448Gx, _ := new(big.Int).SetString("224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710", 10)
+	ed448Generator := encodeEdwardsPoint(ed448Curve, ed448Gy, ed448Gx.Bit(0))
+	ed448HighBits := append([]byte{}, ed448Generator...)
+	ed448HighBits[56] |= 1
+	ed448Minus1 := new(big.Int).Sub(ed448Curve.p, big.NewInt(1))
+
+	testCases := []struct {
+		name     string
+		spki     []byte
+		curve    string
+		problems []string
+	}{
+		{
+			name:  "valid P-256",
+			spki:  valid,
+			curve: "P-256",
+		},
+		{
+			name:  "valid P-384",
+			spki:  validP384,
+			curve: "P-384",
+		},
+		{
+			name:  "valid P-521",
+			spki:  validP521,
+			curve: "P-521",
+		},
+		{
+			name:     "compressed point",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, p256OID, elliptic.MarshalCompressed(p256, key.X, key.Y)),
+			curve:    "P-256",
+			problems: []string{"the point is compressed, rather than in the uncompressed form"},
+		},
+		{
+			name:     "point not on the curve",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, p256OID, offCurve),
+			curve:    "P-256",
+			problems: []string{"the point is not on the curve P-256"},
+		},
+		{
+			name:     "coordinate out of range",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, p256OID, outOfRange),
+			curve:    "P-256",
+			problems: []string{"the coordinates of the point are not elements of the field of P-256"},
+		},
+		{
+			name:     "point at infinity",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, p256OID, []byte{0}),
+			curve:    "P-256",
+			problems: []string{"the point is the point at infinity"},
+		},
+		{
+			name:     "invalid point encoding",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, p256OID, uncompressed[:64]),
+			curve:    "P-256",
+			problems: []string{"the point is not a valid encoding of a point on P-256"},
+		},
+		{
+			name:     "explicit parameters",
+			spki:     marshalTestSPKI(t, oidPublicKeyEC, explicit, uncompressed),
+			curve:    "P-256",
+			problems: []string{"the curve is given by explicit parameters, rather than by the named curve P-256"},
+		},
+		{
+			name: "explicit parameters of an unknown curve",
+			spki: marshalTestSPKI(t, oidPublicKeyEC, wrongGenerator, uncompressed),
+		},
+		{
+			name: "unsupported named curve",
+			spki: marshalTestSPKI(t, oidPublicKeyEC, asn1.ObjectIdentifier{1, 3, 132, 0, 10}, uncompressed),
+		},
+		{
+			name:  "valid Ed25519",
+			spki:  validEd25519,
+			curve: "Ed25519",
+		},
+		{
+			name:     "Ed25519 with parameters",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, asn1.NullRawValue, edKey),
+			curve:    "Ed25519",
+			problems: []string{"the Ed25519 AlgorithmIdentifier has parameters, which must be absent"},
+		},
+		{
+			name:     "Ed25519 neutral element",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, nil, encodeEdwardsPoint(ed25519Curve, big.NewInt(1), 0)),
+			curve:    "Ed25519",
+			problems: []string{"the point is the neutral element"},
+		},
+		{
+			name:     "Ed25519 point of small order",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, nil, encodeEdwardsPoint(ed25519Curve, ed25519Minus1, 0)),
+			curve:    "Ed25519",
+			problems: []string{"the point is not in the subgroup of prime order of Ed25519"},
+		},
+		{
+			name:     "Ed25519 y coordinate out of range",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, nil, encodeEdwardsPoint(ed25519Curve, ed25519Curve.p, 0)),
+			curve:    "Ed25519",
+			problems: []string{"the y coordinate of the point is not an element of the field of Ed25519"},
+		},
+		{
+			name:     "Ed25519 negative zero x coordinate",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, nil, encodeEdwardsPoint(ed25519Curve, big.NewInt(1), 1)),
+			curve:    "Ed25519",
+			problems: []string{"the point is not on the curve Ed25519"},
+		},
+		{
+			name:     "Ed25519 wrong length",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd25519, nil, edKey[:31]),
+			curve:    "Ed25519",
+			problems: []string{"the Ed25519 public key is 31 octets long, rather than 32"},
+		},
+		{
+			name:  "valid Ed448",
+			spki:  marshalTestSPKI(t, oidPublicKeyEd448, nil, ed448Generator),
+			curve: "Ed448",
+		},
+		{
+			name:     "Ed448 with unused bits set",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd448, nil, ed448HighBits),
+			curve:    "Ed448",
+			problems: []string{"the y coordinate of the point is not an element of the field of Ed448"},
+		},
+		{
+			name:     "Ed448 point of small order",
+			spki:     marshalTestSPKI(t, oidPublicKeyEd448, nil, encodeEdwardsPoint(ed448Curve, ed448Minus1, 0)),
+			curve:    "Ed448",
+			problems: []string{"the point is not in the subgroup of prime order of Ed448"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if curve := ECPublicKeyCurve(tc.spki); curve != tc.curve {
+				t.Fatalf("expected curve %q, got %q", tc.curve, curve)
+			}
+			if tc.curve == "" {
+				return
+			}
+			if problems := ValidateECPublicKey(tc.spki); !reflect.DeepEqual(problems, tc.problems) {
+				t.Errorf("expected problems %q, got %q", tc.problems, problems)
+			}
+		})
+	}
+}
+
+func TestECPublicKeyCurveNotEC(t *testing.T) {
+	if curve := ECPublicKeyCurve([]byte{0x30, 0x00}); curve != "" {
+		t.Errorf("expected no curve for a malformed SubjectPublicKeyInfo, got %q", curve)
+	}
+	rsa := marshalTestSPKI(t, asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}, asn1.NullRawValue, []byte{0x30, 0x00})
+	if curve := ECPublicKeyCurve(rsa); curve != "" {
+		t.Errorf("expected no curve for an RSA key, got %q", curve)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+// GeneralName tags as defined in RFC 5280 section 4.2.1.6.
+const (
+	OtherNameTag                 = 0
+	RFC822NameTag                = 1
+	X400AddressTag               = 3
+	DirectoryNameTag             = 4
+	EDIPartyNameTag              = 5
+	UniformResourceIdentifierTag = 6
+	IPAddressTag                 = 7
+	RegisteredIDTag              = 8
+)
+
+var generalNameTypes = map[int]string{
+	OtherNameTag:                 "otherName",
+	RFC822NameTag:                "rfc822Name",
+	DNSNameTag:                   "dNSName",
+	X400AddressTag:               "x400Address",
+	DirectoryNameTag:             "directoryName",
+	EDIPartyNameTag:              "ediPartyName",
+	UniformResourceIdentifierTag: "uniformResourceIdentifier",
+	IPAddressTag:                 "iPAddress",
+	RegisteredIDTag:              "registeredID",
+}
+
+// GeneralNameType returns the RFC 5280 name of the GeneralName choice with the
+// given context specific tag, or "unknown" if the tag is not a GeneralName
+// choice.
+func GeneralNameType(tag int) string {
+	if name, ok := generalNameTypes[tag]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseGeneralNames splits the DER encoded GeneralNames found in the value of
+// a subjectAltName or issuerAltName extension into its individual GeneralName
+// elements, preserving their encoded order. The returned values are left
+// undecoded so that callers are able to inspect the exact encoding.
+func ParseGeneralNames(der []byte) ([]asn1.RawValue, error) {
+	var seq asn1.RawValue
+	rest, err := asn1.Unmarshal(der, &seq)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after GeneralNames")
+	}
+	if seq.Class != asn1.ClassUniversal || seq.Tag != asn1.TagSequence || !seq.IsCompound {
+		return nil, errors.New("GeneralNames is not a SEQUENCE")
+	}
+	var names []asn1.RawValue
+	for rest = seq.Bytes; len(rest) > 0; {
+		var name asn1.RawValue
+		rest, err = asn1.Unmarshal(rest, &name)
+		if err != nil {
+			return nil, err
+		}
+		if name.Class != asn1.ClassContextSpecific {
+			return nil, errors.New("GeneralName is not context specific")
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// GeneralNameIndex returns the index, in encoded order, of the nth (counting
+// from zero) GeneralName with the given tag within the DER encoded
+// GeneralNames. This maps the position of a name within one of the typed
+// slices of a parsed certificate, such as x509.Certificate.DNSNames, back to
+// its position within the extension. If there is no such name, or the
+// GeneralNames can not be parsed, then -1 is returned.
+func GeneralNameIndex(der []byte, tag int, nth int) int {
+	names, err := ParseGeneralNames(der)
+	if err != nil {
+		return -1
+	}
+	for i, name := range names {
+		if name.Tag != tag {
+			continue
+		}
+		if nth == 0 {
+			return i
+		}
+		nth--
+	}
+	return -1
+}
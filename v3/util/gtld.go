@@ -15,7 +15,11 @@
 package util
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -70,14 +74,77 @@ func (p GTLDPeriod) Valid(when time.Time) error {
 	return nil
 }
 
+// TLDMap is a snapshot of the top-level domains and their validity periods.
+type TLDMap struct {
+	periods map[string]GTLDPeriod
+}
+
+var defaultTLDMap = &TLDMap{periods: tldMap}
+
+// DefaultTLDMap returns the snapshot of the top-level domains that is built
+// into ZLint. See the `zlint-gtld-update` command for more information.
+func DefaultTLDMap() *TLDMap {
+	return defaultTLDMap
+}
+
+// GTLDSnapshot is the JSON encoding of a TLDMap. It has the form of the ICANN
+// gTLD v2 JSON registry, and is written by `zlint-gtld-update -json`.
+type GTLDSnapshot struct {
+	GTLDs []GTLDPeriod
+}
+
+// ReadTLDMap reads the GTLDSnapshot at path.
+func ReadTLDMap(path string) (*TLDMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := ParseTLDMap(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// ParseTLDMap parses a JSON encoded GTLDSnapshot. Entries that were never
+// delegated, which have no DelegationDate, are skipped. An error is returned
+// if an entry has a date that cannot be parsed or the snapshot has no
+// delegated entries.
+func ParseTLDMap(r io.Reader) (*TLDMap, error) {
+	var snapshot GTLDSnapshot
+	//nolint:musttag
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, err
+	}
+	periods := make(map[string]GTLDPeriod, len(snapshot.GTLDs))
+	for _, p := range snapshot.GTLDs {
+		if p.DelegationDate == "" {
+			continue
+		}
+		if _, err := time.Parse(GTLDPeriodDateFormat, p.DelegationDate); err != nil {
+			return nil, fmt.Errorf("gTLD %q: %w", p.GTLD, err)
+		}
+		if _, err := time.Parse(GTLDPeriodDateFormat, p.RemovalDate); p.RemovalDate != "" && err != nil {
+			return nil, fmt.Errorf("gTLD %q: %w", p.GTLD, err)
+		}
+		p.GTLD = strings.ToLower(p.GTLD)
+		periods[p.GTLD] = p
+	}
+	if len(periods) == 0 {
+		return nil, errors.New("the gTLD snapshot has no delegated gTLDs")
+	}
+	return &TLDMap{periods: periods}, nil
+}
+
 // HasValidTLD checks that a domain ends in a valid TLD that was delegated in
 // the root DNS at the time specified.
-func HasValidTLD(domain string, when time.Time) bool {
+func (m *TLDMap) HasValidTLD(domain string, when time.Time) bool {
 	labels := strings.Split(strings.ToLower(domain), ".")
 	rightLabel := labels[len(labels)-1]
-	// if the rightmost label is not present in the tldMap, it isn't valid and
+	// if the rightmost label is not present in the map, it isn't valid and
 	// never was.
-	if tldPeriod, present := tldMap[rightLabel]; !present {
+	if tldPeriod, present := m.periods[rightLabel]; !present {
 		return false
 	} else if tldPeriod.Valid(when) != nil {
 		// If the TLD exists but the date is outside of the gTLD's validity period
@@ -89,16 +156,25 @@ func HasValidTLD(domain string, when time.Time) bool {
 	return true
 }
 
-// IsInTLDMap checks that a label is present in the TLD map. It does not
+// IsInTLDMap checks that a label is present in the map. It does not consider
+// the TLD's validity period and whether the TLD may have been removed, only
+// whether it was ever a TLD that was delegated.
+func (m *TLDMap) IsInTLDMap(label string) bool {
+	_, ok := m.periods[strings.ToLower(label)]
+	return ok
+}
+
+// HasValidTLD checks that a domain ends in a valid TLD that was delegated in
+// the root DNS at the time specified, according to the DefaultTLDMap.
+func HasValidTLD(domain string, when time.Time) bool {
+	return DefaultTLDMap().HasValidTLD(domain, when)
+}
+
+// IsInTLDMap checks that a label is present in the DefaultTLDMap. It does not
 // consider the TLD's validity period and whether the TLD may have been removed,
 // only whether it was ever a TLD that was delegated.
 func IsInTLDMap(label string) bool {
-	label = strings.ToLower(label)
-	if _, ok := tldMap[label]; ok {
-		return true
-	} else {
-		return false
-	}
+	return DefaultTLDMap().IsInTLDMap(label)
 }
 
 // CertificateSubjContainsTLD checks whether the provided Certificate has
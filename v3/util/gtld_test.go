@@ -15,6 +15,7 @@
 package util
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -44,3 +45,53 @@ func TestHasValidTLDUppercaseName(t *testing.T) {
 		)
 	}
 }
+
+func TestReadTLDMap(t *testing.T) {
+	tlds, err := ReadTLDMap("../testdata/gtldSnapshot.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	when := time.Date(2017, time.August, 28, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		domain   string
+		expected bool
+	}{
+		{"example.com", true},
+		{"example.ukei", true},
+		{"example.UKEI", true},
+		{"example.uk", false},
+		{"example.pending", false},
+		{"example.org", false},
+	}
+	for _, tc := range testCases {
+		if actual := tlds.HasValidTLD(tc.domain, when); actual != tc.expected {
+			t.Errorf("For %s expected %v got %v", tc.domain, tc.expected, actual)
+		}
+	}
+	if !tlds.IsInTLDMap("uk") {
+		t.Error("expected the removed uk TLD to be in the map")
+	}
+	if tlds.IsInTLDMap("pending") {
+		t.Error("expected the undelegated pending TLD not to be in the map")
+	}
+}
+
+func TestParseTLDMapErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		snapshot string
+	}{
+		{"malformed", `{"GTLDs": [`},
+		{"empty", `{"GTLDs": []}`},
+		{"undelegated", `{"GTLDs": [{"GTLD": "pending", "DelegationDate": ""}]}`},
+		{"bad delegation date", `{"GTLDs": [{"GTLD": "com", "DelegationDate": "01/01/1985"}]}`},
+		{"bad removal date", `{"GTLDs": [{"GTLD": "com", "DelegationDate": "1985-01-01", "RemovalDate": "soon"}]}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseTLDMap(strings.NewReader(tc.snapshot)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+)
+
+// DebianWeakKeys is a blocklist of the RSA keys generated by the Debian
+// OpenSSL package with a predictable random number generator (CVE-2008-0166).
+//
+// Blocklists are read in the format of the openssl-blacklist package[0], in
+// which each line is the last 20 hexadecimal digits of the SHA-1 hash of the
+// line "Modulus=<N>\n", where <N> is the modulus in uppercase hexadecimal as
+// printed by `openssl rsa -noout -modulus`. Full 40 digit hashes are also
+// accepted. Empty lines and lines starting with "#" are ignored. For example...
+//
+// ```
+// # RSA-2048
+// 000011a0ae41f00fb1fb
+// 000016a5b9d5f8e9c98d
+// ```
+//
+// [0]: https://packages.debian.org/sid/openssl-blacklist
+type DebianWeakKeys struct {
+	hashes hashSet
+}
+
+// ParseDebianWeakKeys parses a Debian weak key blocklist.
+func ParseDebianWeakKeys(r io.Reader) (*DebianWeakKeys, error) {
+	keys := &DebianWeakKeys{hashes: hashSet{size: debianFingerprintSize}}
+	if err := keys.hashes.parse(r, decodeDebianFingerprint); err != nil {
+		return nil, err
+	}
+	keys.hashes.sort()
+	return keys, nil
+}
+
+// ReadDebianWeakKeys reads and merges the Debian weak key blocklists at the
+// given paths, such as one blocklist per key size.
+func ReadDebianWeakKeys(paths ...string) (*DebianWeakKeys, error) {
+	keys := &DebianWeakKeys{hashes: hashSet{size: debianFingerprintSize}}
+	for _, path := range paths {
+		err := readBlocklist(path, func(r io.Reader) error {
+			return keys.hashes.parse(r, decodeDebianFingerprint)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	keys.hashes.sort()
+	return keys, nil
+}
+
+// Len returns the number of keys in the blocklist.
+func (k *DebianWeakKeys) Len() int {
+	return k.hashes.len()
+}
+
+// Contains reports whether the RSA public key is in the blocklist.
+func (k *DebianWeakKeys) Contains(key *rsa.PublicKey) bool {
+	return k.hashes.contains(DebianWeakKeyFingerprint(key.N))
+}
+
+// debianFingerprintSize is the size of the truncated SHA-1 hashes of a Debian
+// weak key blocklist.
+const debianFingerprintSize = 10
+
+// decodeDebianFingerprint decodes a line of a Debian weak key blocklist.
+func decodeDebianFingerprint(line string) ([]byte, error) {
+	if len(line) == 2*sha1.Size {
+		line = line[len(line)-2*debianFingerprintSize:]
+	}
+	return hex.DecodeString(line)
+}
+
+// DebianWeakKeyFingerprint returns the truncated SHA-1 hash by which the RSA
+// modulus n is listed in a Debian weak key blocklist.
+func DebianWeakKeyFingerprint(n *big.Int) []byte {
+	sum := sha1.Sum([]byte(fmt.Sprintf("Modulus=%X\n", n)))
+	return sum[len(sum)-debianFingerprintSize:]
+}
+
+// CompromisedKeys is a blocklist of public keys known to be compromised, such
+// as keys that have been publicly disclosed or reported in key compromise
+// revocation requests.
+//
+// Blocklists are read in a format in which each line is the SHA-256 hash of
+// the DER encoded SubjectPublicKeyInfo of a key in hexadecimal, as printed by
+// `openssl pkey -pubin -outform DER | sha256sum`. Empty lines and lines
+// starting with "#" are ignored. For example...
+//
+// ```
+// # Disclosed in a public repository
+// 2d1ec2aa4c5bb1fb3b3bb2ab6e3ac8a6e4b4dcd0b5b09c1a6b9c30fa7a1dd45f
+// ```
+type CompromisedKeys struct {
+	hashes hashSet
+}
+
+// ParseCompromisedKeys parses a compromised key blocklist.
+func ParseCompromisedKeys(r io.Reader) (*CompromisedKeys, error) {
+	keys := &CompromisedKeys{hashes: hashSet{size: sha256.Size}}
+	if err := keys.hashes.parse(r, hex.DecodeString); err != nil {
+		return nil, err
+	}
+	keys.hashes.sort()
+	return keys, nil
+}
+
+// ReadCompromisedKeys reads and merges the compromised key blocklists at the
+// given paths.
+func ReadCompromisedKeys(paths ...string) (*CompromisedKeys, error) {
+	keys := &CompromisedKeys{hashes: hashSet{size: sha256.Size}}
+	for _, path := range paths {
+		err := readBlocklist(path, func(r io.Reader) error {
+			return keys.hashes.parse(r, hex.DecodeString)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	keys.hashes.sort()
+	return keys, nil
+}
+
+// Len returns the number of keys in the blocklist.
+func (k *CompromisedKeys) Len() int {
+	return k.hashes.len()
+}
+
+// Contains reports whether the public key with the given DER encoded
+// SubjectPublicKeyInfo, such as the RawSubjectPublicKeyInfo of a certificate
+// or of a certificate signing request, is in the blocklist.
+func (k *CompromisedKeys) Contains(spki []byte) bool {
+	sum := sha256.Sum256(spki)
+	return k.hashes.contains(sum[:])
+}
+
+// readBlocklist opens the blocklist at path and parses it with parse.
+func readBlocklist(path string, parse func(io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := parse(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// hashSet is a sorted set of hashes of the same size. Blocklists hold millions
+// of keys, so rather than in a map the hashes are held back to back in a
+// single slice and found by binary search.
+type hashSet struct {
+	size   int
+	hashes []byte
+}
+
+// parse appends a hash for every line of r that is neither empty nor a
+// comment, as decoded by decode. The set must be sorted afterwards.
+func (s *hashSet) parse(r io.Reader, decode func(string) ([]byte, error)) error {
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, err := decode(line)
+		if err != nil || len(hash) != s.size {
+			return fmt.Errorf("line %d: %q is not a hexadecimal hash of %d bytes", number, line, s.size)
+		}
+		s.hashes = append(s.hashes, hash...)
+	}
+	return scanner.Err()
+}
+
+// sort sorts the hashes and removes duplicates.
+func (s *hashSet) sort() {
+	sort.Sort((*hashSorter)(s))
+	unique := 0
+	for i := 0; i < s.len(); i++ {
+		if unique == 0 || !bytes.Equal(s.at(i), s.at(unique-1)) {
+			copy(s.at(unique), s.at(i))
+			unique++
+		}
+	}
+	s.hashes = s.hashes[: unique*s.size : unique*s.size]
+}
+
+func (s *hashSet) len() int {
+	return len(s.hashes) / s.size
+}
+
+func (s *hashSet) at(i int) []byte {
+	return s.hashes[i*s.size : (i+1)*s.size]
+}
+
+// contains reports whether hash is in the set.
+func (s *hashSet) contains(hash []byte) bool {
+	i := sort.Search(s.len(), func(i int) bool {
+		return bytes.Compare(s.at(i), hash) >= 0
+	})
+	return i < s.len() && bytes.Equal(s.at(i), hash)
+}
+
+// hashSorter implements sort.Interface for a hashSet.
+type hashSorter hashSet
+
+func (s *hashSorter) Len() int {
+	return (*hashSet)(s).len()
+}
+
+func (s *hashSorter) Less(i, j int) bool {
+	return bytes.Compare((*hashSet)(s).at(i), (*hashSet)(s).at(j)) < 0
+}
+
+func (s *hashSorter) Swap(i, j int) {
+	// No hash is longer than a SHA-256 hash.
+	var tmp [sha256.Size]byte
+	a, b := (*hashSet)(s).at(i), (*hashSet)(s).at(j)
+	n := copy(tmp[:], a)
+	copy(a, b)
+	copy(b, tmp[:n])
+}
@@ -0,0 +1,133 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/zmap/zcrypto/x509"
+)
+
+func readBlocklistedKey(t *testing.T) *x509.Certificate {
+	t.Helper()
+	data, err := os.ReadFile("../testdata/blocklistedKey.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestDebianWeakKeyFingerprint(t *testing.T) {
+	// Matches `echo "Modulus=FF" | sha1sum`.
+	got := hex.EncodeToString(DebianWeakKeyFingerprint(big.NewInt(255)))
+	want := "2abfa6aa260e50654b72"
+	if got != want {
+		t.Errorf("DebianWeakKeyFingerprint(255) = %s, want %s", got, want)
+	}
+}
+
+func TestReadDebianWeakKeys(t *testing.T) {
+	keys, err := ReadDebianWeakKeys("../testdata/debianWeakKeys.txt", "../testdata/debianWeakKeys.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.Len() != 4 {
+		t.Errorf("expected 4 keys, got %d", keys.Len())
+	}
+	c := readBlocklistedKey(t)
+	if !keys.Contains(c.PublicKey.(*rsa.PublicKey)) {
+		t.Errorf("expected the key of blocklistedKey.pem to be blocklisted")
+	}
+	if keys.Contains(&rsa.PublicKey{N: big.NewInt(255), E: 65537}) {
+		t.Errorf("expected the key with modulus 255 not to be blocklisted")
+	}
+}
+
+func TestParseDebianWeakKeys(t *testing.T) {
+	data := []struct {
+		name   string
+		list   string
+		keys   int
+		hasErr bool
+	}{
+		{"empty", "", 0, false},
+		{"comments", "# RSA-2048\n\n  # 1024\n", 0, false},
+		{"truncated", "00000abe89f3ae7c1d41\n000016c6ae5c5f1fcf7f\n", 2, false},
+		{"full", "0123456789abcdef0123" + "00000abe89f3ae7c1d41\n00000abe89f3ae7c1d41\n", 1, false},
+		{"short", "00000abe89f3ae7c1d4\n", 0, true},
+		{"not hex", "00000abe89f3ae7c1d4x\n", 0, true},
+	}
+	for _, test := range data {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			keys, err := ParseDebianWeakKeys(strings.NewReader(test.list))
+			if (err != nil) != test.hasErr {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if err == nil && keys.Len() != test.keys {
+				t.Errorf("expected %d keys, got %d", test.keys, keys.Len())
+			}
+		})
+	}
+}
+
+func TestReadCompromisedKeys(t *testing.T) {
+	keys, err := ReadCompromisedKeys("../testdata/compromisedKeys.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys.Len() != 2 {
+		t.Errorf("expected 2 keys, got %d", keys.Len())
+	}
+	c := readBlocklistedKey(t)
+	if !keys.Contains(c.RawSubjectPublicKeyInfo) {
+		t.Errorf("expected the key of blocklistedKey.pem to be blocklisted")
+	}
+	if keys.Contains(c.RawTBSCertificate) {
+		t.Errorf("expected the TBSCertificate not to be blocklisted")
+	}
+	_, err = ReadCompromisedKeys("../testdata/debianWeakKeys.txt")
+	if err == nil || !strings.Contains(err.Error(), "debianWeakKeys.txt: line 4") {
+		t.Errorf("expected an error for line 4 of debianWeakKeys.txt, got %v", err)
+	}
+}
+
+func TestHashSetContains(t *testing.T) {
+	var list strings.Builder
+	for i := 255; i >= 0; i -= 2 {
+		list.WriteString(strings.Repeat(hex.EncodeToString([]byte{byte(i)}), 10) + "\n")
+	}
+	keys, err := ParseDebianWeakKeys(strings.NewReader(list.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 256; i++ {
+		hash := []byte(strings.Repeat(string([]byte{byte(i)}), 10))
+		if got, want := keys.hashes.contains(hash), i%2 == 1; got != want {
+			t.Errorf("contains(%x) = %t, want %t", hash, got, want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/weppos/publicsuffix-go/publicsuffix"
+	"github.com/zmap/zcrypto/x509"
+)
+
+// This package uses the `zlint-psl-update` command to generate the
+// `icannPublicSuffixRules` and `privatePublicSuffixRules` lists.
+//go:generate zlint-psl-update ./public_suffix_list_rules.go
+
+const (
+	pslBeginICANN   = "===BEGIN ICANN DOMAINS==="
+	pslBeginPrivate = "===BEGIN PRIVATE DOMAINS==="
+)
+
+// PublicSuffixList is a snapshot of the Public Suffix List[0]. The rules of
+// the ICANN section of the list, which are the suffixes delegated by registries
+// ("registry-controlled" labels in the terms of the BRs), are held apart from
+// the rules of the private section, which are the suffixes of domains whose
+// owners have asked for their subdomains to be treated as registrable.
+// [0] - https://publicsuffix.org/list/
+type PublicSuffixList struct {
+	list    *publicsuffix.List
+	icann   []string
+	private []string
+}
+
+var (
+	defaultPublicSuffixList     *PublicSuffixList
+	defaultPublicSuffixListOnce sync.Once
+)
+
+// DefaultPublicSuffixList returns the snapshot of the Public Suffix List that
+// is built into ZLint. See the `zlint-psl-update` command for more information.
+func DefaultPublicSuffixList() *PublicSuffixList {
+	defaultPublicSuffixListOnce.Do(func() {
+		var err error
+		defaultPublicSuffixList, err = newPublicSuffixList(icannPublicSuffixRules, privatePublicSuffixRules)
+		if err != nil {
+			panic(fmt.Sprintf("invalid built-in Public Suffix List: %s", err))
+		}
+	})
+	return defaultPublicSuffixList
+}
+
+// ReadPublicSuffixList reads the Public Suffix List at path, in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat.
+func ReadPublicSuffixList(path string) (*PublicSuffixList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	list, err := ParsePublicSuffixList(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return list, nil
+}
+
+// ParsePublicSuffixList parses a Public Suffix List in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat. The rules following the
+// "===BEGIN PRIVATE DOMAINS===" comment are those of the private section, and
+// all others are those of the ICANN section. An error is returned if a rule
+// cannot be parsed or the list has no ICANN rules.
+func ParsePublicSuffixList(r io.Reader) (*PublicSuffixList, error) {
+	var icann, private []string
+	inPrivate := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "//"):
+			if strings.Contains(line, pslBeginPrivate) {
+				inPrivate = true
+			} else if strings.Contains(line, pslBeginICANN) {
+				inPrivate = false
+			}
+		case line == "":
+		case inPrivate:
+			private = append(private, strings.Fields(line)[0])
+		default:
+			icann = append(icann, strings.Fields(line)[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(icann) == 0 {
+		return nil, errors.New("the Public Suffix List has no ICANN rules")
+	}
+	return newPublicSuffixList(icann, private)
+}
+
+func newPublicSuffixList(icann, private []string) (*PublicSuffixList, error) {
+	list := publicsuffix.NewList()
+	add := func(rules []string, private bool) error {
+		for _, content := range rules {
+			rule, err := publicsuffix.NewRuleUnicode(content)
+			if err != nil {
+				return fmt.Errorf("invalid Public Suffix List rule %q: %w", content, err)
+			}
+			rule.Private = private
+			if err := list.AddRule(rule); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(icann, false); err != nil {
+		return nil, err
+	}
+	if err := add(private, true); err != nil {
+		return nil, err
+	}
+	return &PublicSuffixList{list: list, icann: icann, private: private}, nil
+}
+
+// ICANNRules returns the rules of the ICANN section of the list, in the order
+// that they were read.
+func (l *PublicSuffixList) ICANNRules() []string {
+	return l.icann
+}
+
+// PrivateRules returns the rules of the private section of the list, in the
+// order that they were read.
+func (l *PublicSuffixList) PrivateRules() []string {
+	return l.private
+}
+
+// ParseDomainName splits name into its public suffix, second level domain and
+// third level domains. The rules of the private section of the list are only
+// used when includePrivate is true. As with x509.Certificate's
+// GetParsedDNSNames, a name that is itself a public suffix results in a
+// ParseError ending in "is a suffix".
+func (l *PublicSuffixList) ParseDomainName(name string, includePrivate bool) x509.ParsedDomainName {
+	parsed, err := publicsuffix.ParseFromListWithOptions(l.list, name,
+		&publicsuffix.FindOptions{IgnorePrivate: !includePrivate, DefaultRule: publicsuffix.DefaultRule})
+	return x509.ParsedDomainName{DomainString: name, ParsedDomain: parsed, ParseError: err}
+}
+
+// ParsedDNSNames parses the DNSNames of c with the ICANN section of the list.
+// It is the equivalent of x509.Certificate's GetParsedDNSNames, which uses
+// the Public Suffix List compiled into the publicsuffix-go dependency.
+func (l *PublicSuffixList) ParsedDNSNames(c *x509.Certificate) []x509.ParsedDomainName {
+	parsed := make([]x509.ParsedDomainName, len(c.DNSNames))
+	for i, name := range c.DNSNames {
+		parsed[i] = l.ParseDomainName(name, false)
+	}
+	return parsed
+}
+
+// ParsedSubjectCommonName parses the subject CommonName of c with the ICANN
+// section of the list. It is the equivalent of x509.Certificate's
+// GetParsedSubjectCommonName.
+func (l *PublicSuffixList) ParsedSubjectCommonName(c *x509.Certificate) x509.ParsedDomainName {
+	return l.ParseDomainName(c.Subject.CommonName, false)
+}
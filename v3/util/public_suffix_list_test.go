@@ -0,0 +1,97 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadPublicSuffixList(t *testing.T) {
+	list, err := ReadPublicSuffixList("../testdata/publicSuffixList.dat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"com", "net", "uk", "us", "*.ck", "!www.ck"}; !reflect.DeepEqual(list.ICANNRules(), want) {
+		t.Errorf("expected ICANN rules %q, got %q", want, list.ICANNRules())
+	}
+	if want := []string{"co.uk"}; !reflect.DeepEqual(list.PrivateRules(), want) {
+		t.Errorf("expected private rules %q, got %q", want, list.PrivateRules())
+	}
+
+	testCases := []struct {
+		name           string
+		includePrivate bool
+		tld, sld, trd  string
+		err            string
+	}{
+		{name: "www.example.com", tld: "com", sld: "example", trd: "www"},
+		{name: "www.example.co.uk", tld: "uk", sld: "co", trd: "www.example"},
+		{name: "www.example.co.uk", includePrivate: true, tld: "co.uk", sld: "example", trd: "www"},
+		{name: "co.uk", tld: "uk", sld: "co"},
+		{name: "co.uk", includePrivate: true, err: "co.uk is a suffix"},
+		{name: "example.test", tld: "test", sld: "example"},
+		{name: "www.example.ck", tld: "example.ck", sld: "www"},
+		{name: "www.ck", tld: "ck", sld: "www"},
+		{name: "com", err: "com is a suffix"},
+	}
+	for _, tc := range testCases {
+		parsed := list.ParseDomainName(tc.name, tc.includePrivate)
+		if parsed.DomainString != tc.name {
+			t.Errorf("%s: expected DomainString %q, got %q", tc.name, tc.name, parsed.DomainString)
+		}
+		if tc.err != "" {
+			if parsed.ParseError == nil || parsed.ParseError.Error() != tc.err {
+				t.Errorf("%s (private %v): expected error %q, got %v", tc.name, tc.includePrivate, tc.err, parsed.ParseError)
+			}
+			continue
+		}
+		if parsed.ParseError != nil {
+			t.Errorf("%s (private %v): unexpected error %v", tc.name, tc.includePrivate, parsed.ParseError)
+			continue
+		}
+		if d := parsed.ParsedDomain; d.TLD != tc.tld || d.SLD != tc.sld || d.TRD != tc.trd {
+			t.Errorf("%s (private %v): expected %q, %q, %q, got %q, %q, %q",
+				tc.name, tc.includePrivate, tc.tld, tc.sld, tc.trd, d.TLD, d.SLD, d.TRD)
+		}
+	}
+}
+
+func TestParsePublicSuffixListErrors(t *testing.T) {
+	if _, err := ParsePublicSuffixList(strings.NewReader("// ===BEGIN PRIVATE DOMAINS===\nexample.com\n")); err == nil {
+		t.Error("expected an error for a list without ICANN rules")
+	}
+	if _, err := ReadPublicSuffixList("../testdata/missingPublicSuffixList.dat"); err == nil {
+		t.Error("expected an error for a missing list")
+	}
+}
+
+func TestDefaultPublicSuffixList(t *testing.T) {
+	list := DefaultPublicSuffixList()
+	if list != DefaultPublicSuffixList() {
+		t.Error("expected the default list to be built once")
+	}
+	if parsed := list.ParseDomainName("www.example.co.uk", false); parsed.ParseError != nil || parsed.ParsedDomain.SLD != "example" {
+		t.Errorf("expected the SLD of www.example.co.uk to be example, got %+v", parsed)
+	}
+	// alwaysdata.net is in the private section of the list.
+	if parsed := list.ParseDomainName("alwaysdata.net", true); parsed.ParseError == nil {
+		t.Error("expected alwaysdata.net to be a private public suffix")
+	}
+	if parsed := list.ParseDomainName("alwaysdata.net", false); parsed.ParseError != nil {
+		t.Errorf("expected alwaysdata.net not to be an ICANN public suffix, got %v", parsed.ParseError)
+	}
+}
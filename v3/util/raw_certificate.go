@@ -0,0 +1,252 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+// RawCertificate holds the undecoded elements of a DER encoded certificate,
+// as laid out by RFC 5280 section 4.1. Unlike x509.ParseCertificate,
+// ParseRawCertificate does not interpret the contents of the elements, which
+// allows the elements of certificates that zcrypto is unable to parse to be
+// inspected.
+//
+// An element that could not be read has a nil FullBytes. Version,
+// IssuerUniqueID, SubjectUniqueID and Extensions also have a nil FullBytes
+// when they are omitted from the certificate.
+type RawCertificate struct {
+	// Raw is the complete DER encoded certificate.
+	Raw []byte
+	// Err is the error returned by ParseRawCertificate, if any, which
+	// describes the first element that could not be read.
+	Err error
+
+	TBSCertificate     asn1.RawValue
+	SignatureAlgorithm asn1.RawValue
+	SignatureValue     asn1.RawValue
+
+	Version              asn1.RawValue
+	SerialNumber         asn1.RawValue
+	Signature            asn1.RawValue
+	Issuer               asn1.RawValue
+	Validity             asn1.RawValue
+	NotBefore            asn1.RawValue
+	NotAfter             asn1.RawValue
+	Subject              asn1.RawValue
+	SubjectPublicKeyInfo asn1.RawValue
+	IssuerUniqueID       asn1.RawValue
+	SubjectUniqueID      asn1.RawValue
+	Extensions           asn1.RawValue
+}
+
+// ParseRawCertificate splits the DER encoded certificate der into its
+// elements. Parsing stops at the first element that is missing, is not DER
+// encoded or has an unexpected tag, in which case an error describing the
+// problem is returned along with the elements that were read before it. The
+// returned RawCertificate is never nil, and holds the error in its Err field.
+func ParseRawCertificate(der []byte) (*RawCertificate, error) {
+	raw := &RawCertificate{Raw: der}
+	raw.Err = raw.parse(der)
+	return raw, raw.Err
+}
+
+// parse splits the DER encoded certificate der into the elements of r.
+func (r *RawCertificate) parse(der []byte) error {
+	var cert asn1.RawValue
+	rest, err := asn1.Unmarshal(der, &cert)
+	if err != nil {
+		return fmt.Errorf("certificate: %w", err)
+	}
+	if len(rest) != 0 {
+		return errors.New("certificate: trailing data")
+	}
+	if !isRawSequence(cert) {
+		return errors.New("certificate: not a SEQUENCE")
+	}
+
+	elements := cert.Bytes
+	if elements, err = readRawSequence(elements, &r.TBSCertificate, "tbsCertificate"); err != nil {
+		return err
+	}
+	if elements, err = readRawSequence(elements, &r.SignatureAlgorithm, "signatureAlgorithm"); err != nil {
+		return err
+	}
+	if elements, err = readRawUniversal(elements, &r.SignatureValue, "signatureValue", asn1.TagBitString, "BIT STRING"); err != nil {
+		return err
+	}
+	if len(elements) != 0 {
+		return errors.New("certificate: unexpected element after signatureValue")
+	}
+	return r.parseTBSCertificate()
+}
+
+// parseTBSCertificate splits the TBSCertificate of r into its elements.
+func (r *RawCertificate) parseTBSCertificate() error {
+	elements := r.TBSCertificate.Bytes
+	var first asn1.RawValue
+	if rest, err := asn1.Unmarshal(elements, &first); err == nil &&
+		first.Class == asn1.ClassContextSpecific && first.Tag == 0 && first.IsCompound {
+		r.Version = first
+		elements = rest
+	}
+	var err error
+	if elements, err = readRawUniversal(elements, &r.SerialNumber, "tbsCertificate.serialNumber", asn1.TagInteger, "INTEGER"); err != nil {
+		return err
+	}
+	if elements, err = readRawSequence(elements, &r.Signature, "tbsCertificate.signature"); err != nil {
+		return err
+	}
+	if elements, err = readRawSequence(elements, &r.Issuer, "tbsCertificate.issuer"); err != nil {
+		return err
+	}
+	if elements, err = readRawSequence(elements, &r.Validity, "tbsCertificate.validity"); err != nil {
+		return err
+	}
+	times := r.Validity.Bytes
+	if times, err = readRawTime(times, &r.NotBefore, "tbsCertificate.validity.notBefore"); err != nil {
+		return err
+	}
+	if times, err = readRawTime(times, &r.NotAfter, "tbsCertificate.validity.notAfter"); err != nil {
+		return err
+	}
+	if len(times) != 0 {
+		return errors.New("tbsCertificate.validity: unexpected element after notAfter")
+	}
+	if elements, err = readRawSequence(elements, &r.Subject, "tbsCertificate.subject"); err != nil {
+		return err
+	}
+	if elements, err = readRawSequence(elements, &r.SubjectPublicKeyInfo, "tbsCertificate.subjectPublicKeyInfo"); err != nil {
+		return err
+	}
+	return r.parseOptionalElements(elements)
+}
+
+// parseOptionalElements reads the issuerUniqueID, subjectUniqueID and
+// extensions elements of a TBSCertificate, each of which is optional but must
+// appear in that order.
+func (r *RawCertificate) parseOptionalElements(elements []byte) error {
+	optional := []*asn1.RawValue{nil, &r.IssuerUniqueID, &r.SubjectUniqueID, &r.Extensions}
+	last := 0
+	for len(elements) > 0 {
+		var element asn1.RawValue
+		var err error
+		if elements, err = asn1.Unmarshal(elements, &element); err != nil {
+			return fmt.Errorf("tbsCertificate: %w", err)
+		}
+		if element.Class != asn1.ClassContextSpecific || element.Tag <= last || element.Tag >= len(optional) {
+			return fmt.Errorf("tbsCertificate: unexpected element with tag [%d] after subjectPublicKeyInfo", element.Tag)
+		}
+		*optional[element.Tag] = element
+		last = element.Tag
+	}
+	return nil
+}
+
+func isRawSequence(v asn1.RawValue) bool {
+	return v.Class == asn1.ClassUniversal && v.Tag == asn1.TagSequence && v.IsCompound
+}
+
+// readRawSequence reads a SEQUENCE named name from in into out, returning the
+// bytes that follow it.
+func readRawSequence(in []byte, out *asn1.RawValue, name string) ([]byte, error) {
+	var v asn1.RawValue
+	rest, err := readRawElement(in, &v, name)
+	if err != nil {
+		return nil, err
+	}
+	if !isRawSequence(v) {
+		return nil, fmt.Errorf("%s: not a SEQUENCE", name)
+	}
+	*out = v
+	return rest, nil
+}
+
+// readRawUniversal reads a primitive element of the universal class with the
+// given tag, named name, from in into out, returning the bytes that follow it.
+func readRawUniversal(in []byte, out *asn1.RawValue, name string, tag int, tagName string) ([]byte, error) {
+	var v asn1.RawValue
+	rest, err := readRawElement(in, &v, name)
+	if err != nil {
+		return nil, err
+	}
+	if v.Class != asn1.ClassUniversal || v.Tag != tag || v.IsCompound {
+		return nil, fmt.Errorf("%s: not a %s", name, tagName)
+	}
+	*out = v
+	return rest, nil
+}
+
+// readRawTime reads a UTCTime or GeneralizedTime, named name, from in into
+// out, returning the bytes that follow it.
+func readRawTime(in []byte, out *asn1.RawValue, name string) ([]byte, error) {
+	var v asn1.RawValue
+	rest, err := readRawElement(in, &v, name)
+	if err != nil {
+		return nil, err
+	}
+	if v.Class != asn1.ClassUniversal || (v.Tag != asn1.TagUTCTime && v.Tag != asn1.TagGeneralizedTime) || v.IsCompound {
+		return nil, fmt.Errorf("%s: not a UTCTime or GeneralizedTime", name)
+	}
+	*out = v
+	return rest, nil
+}
+
+func readRawElement(in []byte, out *asn1.RawValue, name string) ([]byte, error) {
+	if len(in) == 0 {
+		return nil, fmt.Errorf("%s: missing", name)
+	}
+	rest, err := asn1.Unmarshal(in, out)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return rest, nil
+}
+
+// ParseRawTime decodes a UTCTime or GeneralizedTime that is encoded as RFC 5280
+// section 4.1.2.5 requires: in UTC, expressed to the second, without
+// fractional seconds. UTCTime years of 50 or more are interpreted as being in
+// the 20th century. An error is returned for any other encoding.
+func ParseRawTime(v asn1.RawValue) (time.Time, error) {
+	s := string(v.Bytes)
+	switch {
+	case v.Class != asn1.ClassUniversal:
+		return time.Time{}, errors.New("not a UTCTime or GeneralizedTime")
+	case v.Tag == asn1.TagUTCTime:
+		if len(s) != len("YYMMDDHHMMSSZ") {
+			return time.Time{}, fmt.Errorf("UTCTime %q is not of the form YYMMDDHHMMSSZ", s)
+		}
+		if s[0] >= '5' {
+			s = "19" + s
+		} else {
+			s = "20" + s
+		}
+	case v.Tag == asn1.TagGeneralizedTime:
+		if len(s) != len("YYYYMMDDHHMMSSZ") {
+			return time.Time{}, fmt.Errorf("GeneralizedTime %q is not of the form YYYYMMDDHHMMSSZ", s)
+		}
+	default:
+		return time.Time{}, errors.New("not a UTCTime or GeneralizedTime")
+	}
+	t, err := time.Parse("20060102150405Z", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time %q is invalid: %w", v.Bytes, err)
+	}
+	return t, nil
+}
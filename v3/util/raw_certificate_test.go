@@ -0,0 +1,156 @@
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zmap/zcrypto/encoding/asn1"
+)
+
+func rawTestElement(class, tag int, compound bool, content ...[]byte) []byte {
+	der, err := asn1.Marshal(asn1.RawValue{Class: class, Tag: tag, IsCompound: compound, Bytes: bytes.Join(content, nil)})
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+func rawTestSequence(content ...[]byte) []byte {
+	return rawTestElement(asn1.ClassUniversal, asn1.TagSequence, true, content...)
+}
+
+func TestParseRawCertificate(t *testing.T) {
+	version := rawTestElement(asn1.ClassContextSpecific, 0, true, []byte{asn1.TagInteger, 1, 2})
+	serial := rawTestElement(asn1.ClassUniversal, asn1.TagInteger, false, []byte{1})
+	algorithm := rawTestSequence(rawTestElement(asn1.ClassUniversal, asn1.TagOID, false, []byte{0x2a, 0x03}))
+	name := rawTestSequence()
+	notBefore := rawTestElement(asn1.ClassUniversal, asn1.TagUTCTime, false, []byte("240101000000Z"))
+	notAfter := rawTestElement(asn1.ClassUniversal, asn1.TagGeneralizedTime, false, []byte("20500101000000Z"))
+	validity := rawTestSequence(notBefore, notAfter)
+	extensions := rawTestElement(asn1.ClassContextSpecific, 3, true, rawTestSequence())
+	signatureValue := rawTestElement(asn1.ClassUniversal, asn1.TagBitString, false, []byte{0})
+	certificate := func(tbs ...[]byte) []byte {
+		return rawTestSequence(rawTestSequence(tbs...), algorithm, signatureValue)
+	}
+
+	der := certificate(version, serial, algorithm, name, validity, name, name, extensions)
+	raw, err := ParseRawCertificate(der)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(raw.Raw, der) || raw.Err != nil {
+		t.Errorf("expected Raw to be the certificate and no Err, got %x and %v", raw.Raw, raw.Err)
+	}
+	for field, expected := range map[string][]byte{
+		"version":    version,
+		"serial":     serial,
+		"signature":  algorithm,
+		"notBefore":  notBefore,
+		"notAfter":   notAfter,
+		"extensions": extensions,
+	} {
+		actual := map[string]asn1.RawValue{
+			"version":    raw.Version,
+			"serial":     raw.SerialNumber,
+			"signature":  raw.Signature,
+			"notBefore":  raw.NotBefore,
+			"notAfter":   raw.NotAfter,
+			"extensions": raw.Extensions,
+		}[field]
+		if !bytes.Equal(actual.FullBytes, expected) {
+			t.Errorf("expected %s to be %x, got %x", field, expected, actual.FullBytes)
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		der           []byte
+		expectedError string
+		expectSerial  bool
+	}{
+		{
+			name:          "trailing data",
+			der:           append(certificate(serial, algorithm, name, validity, name, name), 0),
+			expectedError: "certificate: trailing data",
+		},
+		{
+			name:          "version omitted and serial number missing",
+			der:           certificate(),
+			expectedError: "tbsCertificate.serialNumber: missing",
+		},
+		{
+			name:          "serial number not an INTEGER",
+			der:           certificate(version, algorithm),
+			expectedError: "tbsCertificate.serialNumber: not a INTEGER",
+		},
+		{
+			name:          "validity time not a time",
+			der:           certificate(serial, algorithm, name, rawTestSequence(serial, notAfter), name, name),
+			expectedError: "tbsCertificate.validity.notBefore: not a UTCTime or GeneralizedTime",
+			expectSerial:  true,
+		},
+		{
+			name:          "optional elements out of order",
+			der:           certificate(serial, algorithm, name, validity, name, name, extensions, extensions),
+			expectedError: "tbsCertificate: unexpected element with tag [3] after subjectPublicKeyInfo",
+			expectSerial:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := ParseRawCertificate(tc.der)
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("expected error %q, got %v", tc.expectedError, err)
+			}
+			if raw.Err != err {
+				t.Errorf("expected Err to be the returned error, got %v", raw.Err)
+			}
+			if (raw.SerialNumber.FullBytes != nil) != tc.expectSerial {
+				t.Errorf("expected serial number to be read: %v, got %x", tc.expectSerial, raw.SerialNumber.FullBytes)
+			}
+		})
+	}
+}
+
+func TestParseRawTime(t *testing.T) {
+	testCases := []struct {
+		tag      int
+		value    string
+		expected time.Time
+		valid    bool
+	}{
+		{asn1.TagUTCTime, "490101000000Z", time.Date(2049, time.January, 1, 0, 0, 0, 0, time.UTC), true},
+		{asn1.TagUTCTime, "500101000000Z", time.Date(1950, time.January, 1, 0, 0, 0, 0, time.UTC), true},
+		{asn1.TagGeneralizedTime, "20500101000000Z", time.Date(2050, time.January, 1, 0, 0, 0, 0, time.UTC), true},
+		{asn1.TagUTCTime, "4901010000Z", time.Time{}, false},
+		{asn1.TagUTCTime, "490101000000+0000", time.Time{}, false},
+		{asn1.TagGeneralizedTime, "20500101000000.5Z", time.Time{}, false},
+		{asn1.TagGeneralizedTime, "20501301000000Z", time.Time{}, false},
+		{asn1.TagPrintableString, "490101000000Z", time.Time{}, false},
+	}
+	for _, tc := range testCases {
+		actual, err := ParseRawTime(asn1.RawValue{Class: asn1.ClassUniversal, Tag: tc.tag, Bytes: []byte(tc.value)})
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tc.value, tc.valid, err)
+		}
+		if !actual.Equal(tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.value, tc.expected, actual)
+		}
+	}
+}
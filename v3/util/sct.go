@@ -0,0 +1,160 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/zmap/zcrypto/cryptobyte"
+	cryptobyte_asn1 "github.com/zmap/zcrypto/cryptobyte/asn1"
+	"github.com/zmap/zcrypto/encoding/asn1"
+	"github.com/zmap/zcrypto/x509"
+	"github.com/zmap/zcrypto/x509/ct"
+)
+
+// PrecertificateTBS returns the TBSCertificate over which CT logs signed the
+// SCTs embedded in the certificate. That is the DER encoded TBSCertificate of
+// the certificate without the SignedCertificateTimestampList extension, as
+// described in RFC 6962, section 3.2.
+func PrecertificateTBS(c *x509.Certificate) ([]byte, error) {
+	input := cryptobyte.String(c.RawTBSCertificate)
+
+	var tbsCert cryptobyte.String
+	if !input.ReadASN1(&tbsCert, cryptobyte_asn1.SEQUENCE) {
+		return nil, errors.New("error reading tbsCertificate")
+	}
+
+	extensionsTag := cryptobyte_asn1.Tag(3).Constructed().ContextSpecific()
+	b := cryptobyte.NewBuilder(nil)
+	b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for !tbsCert.Empty() {
+			var field cryptobyte.String
+			var tag cryptobyte_asn1.Tag
+			if !tbsCert.ReadAnyASN1Element(&field, &tag) {
+				b.SetError(errors.New("error reading tbsCertificate"))
+				return
+			}
+			if tag != extensionsTag {
+				b.AddBytes(field)
+				continue
+			}
+			var explicit, extensions cryptobyte.String
+			if !field.ReadASN1(&explicit, extensionsTag) || !explicit.ReadASN1(&extensions, cryptobyte_asn1.SEQUENCE) {
+				b.SetError(errors.New("error reading tbsCertificate.extensions"))
+				return
+			}
+			var kept [][]byte
+			for !extensions.Empty() {
+				var extension, body cryptobyte.String
+				var oid asn1.ObjectIdentifier
+				if !extensions.ReadASN1Element(&extension, cryptobyte_asn1.SEQUENCE) {
+					b.SetError(errors.New("error reading tbsCertificate.extensions"))
+					return
+				}
+				element := extension
+				if !element.ReadASN1(&body, cryptobyte_asn1.SEQUENCE) || !body.ReadASN1ObjectIdentifier(&oid) {
+					b.SetError(errors.New("error reading tbsCertificate.extensions"))
+					return
+				}
+				if !oid.Equal(TimestampOID) {
+					kept = append(kept, extension)
+				}
+			}
+			// Extensions must contain at least one extension, so they are
+			// left out altogether if the SCT list was the only one.
+			if len(kept) == 0 {
+				continue
+			}
+			b.AddASN1(extensionsTag, func(b *cryptobyte.Builder) {
+				b.AddASN1(cryptobyte_asn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					for _, extension := range kept {
+						b.AddBytes(extension)
+					}
+				})
+			})
+		}
+	})
+	return b.Bytes()
+}
+
+// VerifyEmbeddedSCT verifies the signature of an SCT embedded in the
+// certificate, which was issued by issuer, using the public key of the CT log
+// that issued the SCT given as a DER encoded SubjectPublicKeyInfo.
+func VerifyEmbeddedSCT(c, issuer *x509.Certificate, sct *ct.SignedCertificateTimestamp, logKey []byte) error {
+	if sct.SCTVersion != ct.V1 {
+		return fmt.Errorf("unsupported SCT version %d", sct.SCTVersion)
+	}
+	if sct.Signature.HashAlgorithm != ct.SHA256 {
+		return fmt.Errorf("unsupported SCT hash algorithm %s", sct.Signature.HashAlgorithm)
+	}
+	tbs, err := PrecertificateTBS(c)
+	if err != nil {
+		return err
+	}
+	key, err := x509.ParsePKIXPublicKey(logKey)
+	if err != nil {
+		return fmt.Errorf("error parsing the log's public key: %w", err)
+	}
+
+	// The digitally-signed struct of RFC 6962, section 3.2, for a
+	// precert_entry.
+	issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint8(uint8(sct.SCTVersion))
+	b.AddUint8(0) // certificate_timestamp
+	b.AddUint32(uint32(sct.Timestamp >> 32))
+	b.AddUint32(uint32(sct.Timestamp))
+	b.AddUint16(1) // precert_entry
+	b.AddBytes(issuerKeyHash[:])
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes(tbs)
+	})
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes(sct.Extensions)
+	})
+	signed, err := b.Bytes()
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(signed)
+
+	if augmented, ok := key.(*x509.AugmentedECDSA); ok {
+		key = augmented.Pub
+	}
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if sct.Signature.SignatureAlgorithm != ct.ECDSA {
+			return fmt.Errorf("SCT signature algorithm %s does not match the log's ECDSA key", sct.Signature.SignatureAlgorithm)
+		}
+		if !ecdsa.VerifyASN1(key, digest[:], sct.Signature.Signature) {
+			return errors.New("invalid SCT signature")
+		}
+	case *rsa.PublicKey:
+		if sct.Signature.SignatureAlgorithm != ct.RSA {
+			return fmt.Errorf("SCT signature algorithm %s does not match the log's RSA key", sct.Signature.SignatureAlgorithm)
+		}
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sct.Signature.Signature); err != nil {
+			return errors.New("invalid SCT signature")
+		}
+	default:
+		return fmt.Errorf("unsupported log public key type %T", key)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package util
+
+/*
+ * ZLint Copyright 2024 Regents of the University of Michigan
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not
+ * use this file except in compliance with the License. You may obtain a copy
+ * of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+ * implied. See the License for the specific language governing
+ * permissions and limitations under the License.
+ */
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrecertificateTBS(t *testing.T) {
+	// Without an SCT list the TBSCertificate is unchanged.
+	c := readTestdataCertificate(t, "ctNoSCTs.pem")
+	tbs, err := PrecertificateTBS(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tbs, c.RawTBSCertificate) {
+		t.Error("expected the TBSCertificate of a certificate without SCTs to be unchanged")
+	}
+
+	c = readTestdataCertificate(t, "sctEmbeddedValid.pem")
+	tbs, err = PrecertificateTBS(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oid := []byte{0x06, 0x0a, 0x2b, 0x06, 0x01, 0x04, 0x01, 0xd6, 0x79, 0x02, 0x04, 0x02}
+	if !bytes.Contains(c.RawTBSCertificate, oid) || bytes.Contains(tbs, oid) {
+		t.Error("expected the SCT list extension to be removed from the TBSCertificate")
+	}
+}
+
+func TestVerifyEmbeddedSCT(t *testing.T) {
+	list, err := ReadCTLogList("../testdata/sctLogList.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := readTestdataCertificate(t, "sctIssuer.pem")
+	c := readTestdataCertificate(t, "sctEmbeddedInvalid.pem")
+	// The SCTs at index 1 and 3 were signed over a different timestamp.
+	for i, want := range []bool{true, false} {
+		sct := c.SignedCertificateTimestampList[i]
+		err := VerifyEmbeddedSCT(c, issuer, sct, list.Log(sct.LogID).Key)
+		if (err == nil) != want {
+			t.Errorf("SCT %d: expected valid %v got error %v", i, want, err)
+		}
+	}
+	// Verifying with another issuer fails.
+	sct := c.SignedCertificateTimestampList[0]
+	if err := VerifyEmbeddedSCT(c, c, sct, list.Log(sct.LogID).Key); err == nil {
+		t.Error("expected an error verifying an SCT with the wrong issuer")
+	}
+}
@@ -26,6 +26,7 @@ import (
 	_ "github.com/zmap/zlint/v3/lints/cabf_cs_br"
 	_ "github.com/zmap/zlint/v3/lints/cabf_ev"
 	_ "github.com/zmap/zlint/v3/lints/cabf_smime_br"
+	_ "github.com/zmap/zlint/v3/lints/chrome"
 	_ "github.com/zmap/zlint/v3/lints/community"
 	_ "github.com/zmap/zlint/v3/lints/etsi"
 	_ "github.com/zmap/zlint/v3/lints/mozilla"
@@ -35,6 +36,56 @@ import (
 
 const Version int64 = 3
 
+// Option alters how the lints of a registry are executed by LintCertificateEx,
+// LintRevocationListEx, LintOcspResponseEx and LintCertificateRequestEx.
+type Option func(*options)
+
+type options struct {
+	stopAt     lint.LintStatus
+	cheapFirst bool
+	observers  []lint.Observer
+}
+
+// StopAt stops linting as soon as a lint produces a result with a status at or
+// above status, for example lint.Error when only whether an input has any
+// errors is of interest. The ResultSet then only holds the results of the lints
+// that were executed, and has StoppedEarly set.
+func StopAt(status lint.LintStatus) Option {
+	return func(o *options) {
+		o.stopAt = status
+	}
+}
+
+// CheapLintsFirst executes the lints of a registry in order of their
+// lint.LintCost, so that combined with StopAt an answer is reached without
+// executing expensive lints whenever a cheaper lint already reached it.
+func CheapLintsFirst() Option {
+	return func(o *options) {
+		o.cheapFirst = true
+	}
+}
+
+// WithObserver notifies o before and after each lint is executed. It may be
+// given more than once to add several Observers.
+func WithObserver(o lint.Observer) Option {
+	return func(opts *options) {
+		opts.observers = append(opts.observers, o)
+	}
+}
+
+// stop returns true if linting should stop after a lint produced res.
+func (o options) stop(res *lint.LintResult) bool {
+	return o.stopAt != lint.Reserved && res.Status >= o.stopAt
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 // LintCertificate runs all registered lints on c using default options,
 // producing a ResultSet.
 //
@@ -49,8 +100,9 @@ func LintCertificate(c *x509.Certificate) *ResultSet {
 // lints that will be run. (See lint.Registry.Filter())
 //
 // If registry is nil then the global registry of all lints is used and this
-// function is equivalent to calling LintCertificate(c).
-func LintCertificateEx(c *x509.Certificate, registry lint.Registry) *ResultSet {
+// function is equivalent to calling LintCertificate(c). Any Options alter how
+// the lints are executed.
+func LintCertificateEx(c *x509.Certificate, registry lint.Registry, opts ...Option) *ResultSet {
 	if c == nil {
 		return nil
 	}
@@ -58,7 +110,40 @@ func LintCertificateEx(c *x509.Certificate, registry lint.Registry) *ResultSet {
 		registry = lint.GlobalRegistry()
 	}
 	res := new(ResultSet)
-	res.executeCertificate(c, registry)
+	res.executeCertificate(c, registry, newOptions(opts))
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintRawCertificate runs all registered lints on the DER encoded certificate
+// der using default options, producing a ResultSet.
+//
+// Using this function is equivalent to calling LintRawCertificateEx with a nil
+// registry.
+func LintRawCertificate(der []byte) *ResultSet {
+	return LintRawCertificateEx(der, nil)
+}
+
+// LintRawCertificateEx lints the DER encoded certificate der. If der can be
+// parsed then the certificate lints of registry are run against it, exactly as
+// by LintCertificateEx. Otherwise the raw certificate lints of registry, which
+// inspect the DER encoding directly, are run against it instead so that
+// malformed certificates still produce findings. The raw certificate lints
+// include e_cert_unparseable, which reports the parsing error as a Fatal
+// result.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintRawCertificateEx(der []byte, registry lint.Registry, opts ...Option) *ResultSet {
+	if c, err := x509.ParseCertificate(der); err == nil {
+		return LintCertificateEx(c, registry, opts...)
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	res := new(ResultSet)
+	res.executeRawCertificate(der, registry, newOptions(opts))
 	res.Version = Version
 	res.Timestamp = time.Now().Unix()
 	return res
@@ -77,8 +162,9 @@ func LintRevocationList(r *x509.RevocationList) *ResultSet {
 // lints that will be run. (See lint.Registry.Filter())
 //
 // If registry is nil then the global registry of all lints is used and this
-// function is equivalent to calling LintRevocationList(r).
-func LintRevocationListEx(r *x509.RevocationList, registry lint.Registry) *ResultSet {
+// function is equivalent to calling LintRevocationList(r). Any Options alter
+// how the lints are executed.
+func LintRevocationListEx(r *x509.RevocationList, registry lint.Registry, opts ...Option) *ResultSet {
 	if r == nil {
 		return nil
 	}
@@ -86,7 +172,68 @@ func LintRevocationListEx(r *x509.RevocationList, registry lint.Registry) *Resul
 		registry = lint.GlobalRegistry()
 	}
 	res := new(ResultSet)
-	res.executeRevocationList(r, registry)
+	res.executeRevocationList(r, registry, newOptions(opts))
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// crlUnparseable and csrUnparseable are the LintMetadata of the results
+// reported by LintRawRevocationListEx and LintRawCertificateRequestEx for
+// inputs that can not be parsed. Their names are reserved: no lint is
+// registered under them, so a result under either name always means that the
+// input could not be parsed and that no other lints were run against it.
+var (
+	crlUnparseable = lint.LintMetadata{
+		Name:        "e_crl_unparseable",
+		Description: "CRLs must be able to be parsed, as otherwise no revocation list lints can be executed",
+		Citation:    "RFC 5280: 5.1",
+		Source:      lint.Community,
+	}
+	csrUnparseable = lint.LintMetadata{
+		Name:        "e_csr_unparseable",
+		Description: "Certificate signing requests must be able to be parsed, as otherwise no certificate signing request lints can be executed",
+		Citation:    "RFC 2986: 4",
+		Source:      lint.Community,
+	}
+)
+
+// LintRawRevocationList runs all registered lints on the DER encoded revocation
+// list der using default options, producing a ResultSet.
+//
+// Using this function is equivalent to calling LintRawRevocationListEx with
+// a nil registry.
+func LintRawRevocationList(der []byte) *ResultSet {
+	return LintRawRevocationListEx(der, nil)
+}
+
+// LintRawRevocationListEx parses the DER encoded revocation list der and runs
+// the lints of registry on it, exactly as by LintRevocationListEx. If der can
+// not be parsed then the ResultSet instead holds a single Fatal
+// e_crl_unparseable result that describes the parsing error.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintRawRevocationListEx(der []byte, registry lint.Registry, opts ...Option) *ResultSet {
+	r, err := x509.ParseRevocationList(der)
+	if err == nil {
+		return LintRevocationListEx(r, registry, opts...)
+	}
+	return unparseable(crlUnparseable, "unable to parse certificate revocation list: "+err.Error())
+}
+
+// unparseable returns the ResultSet of an input that could not be parsed,
+// which holds a single Fatal result with the given details under the reserved
+// name of meta.
+func unparseable(meta lint.LintMetadata, details string) *ResultSet {
+	res := &ResultSet{Results: map[string]*lint.LintResult{
+		meta.Name: {
+			Status:       lint.Fatal,
+			Details:      details,
+			LintMetadata: meta,
+		},
+	}}
+	res.updateErrorStatePresent(res.Results[meta.Name])
 	res.Version = Version
 	res.Timestamp = time.Now().Unix()
 	return res
@@ -105,8 +252,9 @@ func LintOcspResponse(o *ocsp.Response) *ResultSet {
 // lints that will be run. (See lint.Registry.Filter())
 //
 // If registry is nil then the global registry of all lints is used and this
-// function is equivalent to calling LintOcspResponse(o).
-func LintOcspResponseEx(o *ocsp.Response, registry lint.Registry) *ResultSet {
+// function is equivalent to calling LintOcspResponse(o). Any Options alter how
+// the lints are executed.
+func LintOcspResponseEx(o *ocsp.Response, registry lint.Registry, opts ...Option) *ResultSet {
 	if o == nil {
 		return nil
 	}
@@ -114,8 +262,64 @@ func LintOcspResponseEx(o *ocsp.Response, registry lint.Registry) *ResultSet {
 		registry = lint.GlobalRegistry()
 	}
 	res := new(ResultSet)
-	res.executeOcspResponse(o, registry)
+	res.executeOcspResponse(o, registry, newOptions(opts))
 	res.Version = Version
 	res.Timestamp = time.Now().Unix()
 	return res
 }
+
+// LintCertificateRequest runs all registered lints on r using default options,
+// producing a ResultSet.
+//
+// Using LintCertificateRequest(r) is equivalent to calling
+// LintCertificateRequestEx(r, nil).
+func LintCertificateRequest(r *x509.CertificateRequest) *ResultSet {
+	return LintCertificateRequestEx(r, nil)
+}
+
+// LintCertificateRequestEx runs lints from the provided registry on r producing
+// a ResultSet. Providing an explicit registry allows the caller to filter the
+// lints that will be run. (See lint.Registry.Filter())
+//
+// If registry is nil then the global registry of all lints is used and this
+// function is equivalent to calling LintCertificateRequest(r). Any Options
+// alter how the lints are executed.
+func LintCertificateRequestEx(r *x509.CertificateRequest, registry lint.Registry, opts ...Option) *ResultSet {
+	if r == nil {
+		return nil
+	}
+	if registry == nil {
+		registry = lint.GlobalRegistry()
+	}
+	res := new(ResultSet)
+	res.executeCertificateRequest(r, registry, newOptions(opts))
+	res.Version = Version
+	res.Timestamp = time.Now().Unix()
+	return res
+}
+
+// LintRawCertificateRequest runs all registered lints on the DER encoded
+// certificate signing request der using default options, producing
+// a ResultSet.
+//
+// Using this function is equivalent to calling LintRawCertificateRequestEx with
+// a nil registry.
+func LintRawCertificateRequest(der []byte) *ResultSet {
+	return LintRawCertificateRequestEx(der, nil)
+}
+
+// LintRawCertificateRequestEx parses the DER encoded certificate signing
+// request der and runs the lints of registry on it, exactly as by
+// LintCertificateRequestEx. If der can not be parsed then the ResultSet instead
+// holds a single Fatal e_csr_unparseable result that describes the parsing
+// error.
+//
+// If registry is nil then the global registry of all lints is used. Any Options
+// alter how the lints are executed.
+func LintRawCertificateRequestEx(der []byte, registry lint.Registry, opts ...Option) *ResultSet {
+	r, err := x509.ParseCertificateRequest(der)
+	if err == nil {
+		return LintCertificateRequestEx(r, registry, opts...)
+	}
+	return unparseable(csrUnparseable, "unable to parse certificate signing request: "+err.Error())
+}
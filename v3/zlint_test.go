@@ -1,6 +1,7 @@
 package zlint
 
 import (
+	"encoding/pem"
 	"fmt"
 	"reflect"
 	"strings"
@@ -149,3 +150,207 @@ anything = "at all"
 		t.Fatal("expected lint metadata to have a name, got empty")
 	}
 }
+
+// recordingObserver records the names of the lints it observes.
+type recordingObserver struct {
+	before []string
+	after  []string
+}
+
+func (o *recordingObserver) BeforeLint(meta lint.LintMetadata, input lint.InputType) {
+	o.before = append(o.before, meta.Name)
+}
+
+func (o *recordingObserver) AfterLint(meta lint.LintMetadata, input lint.InputType, duration time.Duration, result *lint.LintResult) {
+	if input != lint.CertificateInput {
+		panic("unexpected input type " + input)
+	}
+	o.after = append(o.after, meta.Name+":"+result.Status.String())
+}
+
+func TestWithObserver(t *testing.T) {
+	observer := &recordingObserver{}
+	timings := lint.NewTimingObserver()
+	registry, err := lint.NewRegistryBuilder().
+		AddCertificateLint(&lint.CertificateLint{
+			LintMetadata: lint.LintMetadata{
+				Name:   "library_usage_test_observer",
+				Source: lint.Community,
+			},
+			Lint: NewConfigurableTestLint,
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	LintCertificateEx(&x509.Certificate{}, registry, WithObserver(observer), WithObserver(timings))
+	LintCertificateEx(&x509.Certificate{}, registry, WithObserver(observer), WithObserver(timings))
+	// Linting without the options notifies no Observers.
+	LintCertificateEx(&x509.Certificate{}, registry)
+
+	expectedBefore := []string{"library_usage_test_observer", "library_usage_test_observer"}
+	if !reflect.DeepEqual(observer.before, expectedBefore) {
+		t.Errorf("expected BeforeLint calls %v, got %v", expectedBefore, observer.before)
+	}
+	expectedAfter := []string{"library_usage_test_observer:pass", "library_usage_test_observer:pass"}
+	if !reflect.DeepEqual(observer.after, expectedAfter) {
+		t.Errorf("expected AfterLint calls %v, got %v", expectedAfter, observer.after)
+	}
+	if profile := timings.Timings(); len(profile) != 1 || profile[0].Runs != 2 {
+		t.Errorf("expected a single lint timed twice, got %+v", profile)
+	}
+}
+
+// statusTestLint always produces a result with its status.
+type statusTestLint struct {
+	status lint.LintStatus
+}
+
+func (l *statusTestLint) CheckApplies(c *x509.Certificate) bool {
+	return true
+}
+
+func (l *statusTestLint) Execute(c *x509.Certificate) *lint.LintResult {
+	return &lint.LintResult{Status: l.status}
+}
+
+func statusTestLintOf(name string, status lint.LintStatus, cost lint.LintCost) *lint.CertificateLint {
+	return &lint.CertificateLint{
+		LintMetadata: lint.LintMetadata{
+			Name:   name,
+			Source: lint.Community,
+			Cost:   cost,
+		},
+		Lint: func() lint.LintInterface {
+			return &statusTestLint{status: status}
+		},
+	}
+}
+
+func TestExecutionOptions(t *testing.T) {
+	observer := &recordingObserver{}
+	registry, err := lint.NewRegistryBuilder().
+		AddCertificateLint(statusTestLintOf("e_library_usage_test_a_expensive", lint.Error, lint.ExpensiveCost)).
+		AddCertificateLint(statusTestLintOf("e_library_usage_test_b_cheap", lint.Error, lint.DefaultCost)).
+		AddCertificateLint(statusTestLintOf("w_library_usage_test_c_cheap", lint.Warn, lint.DefaultCost)).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		opts        []Option
+		expectedRan []string
+		stopped     bool
+	}{
+		{
+			name: "no options",
+			expectedRan: []string{
+				"e_library_usage_test_a_expensive",
+				"e_library_usage_test_b_cheap",
+				"w_library_usage_test_c_cheap",
+			},
+		},
+		{
+			name:        "stop at error",
+			opts:        []Option{StopAt(lint.Error)},
+			expectedRan: []string{"e_library_usage_test_a_expensive"},
+			stopped:     true,
+		},
+		{
+			name: "cheap lints first",
+			opts: []Option{CheapLintsFirst()},
+			expectedRan: []string{
+				"e_library_usage_test_b_cheap",
+				"w_library_usage_test_c_cheap",
+				"e_library_usage_test_a_expensive",
+			},
+		},
+		{
+			name:        "stop at error with cheap lints first",
+			opts:        []Option{StopAt(lint.Error), CheapLintsFirst()},
+			expectedRan: []string{"e_library_usage_test_b_cheap"},
+			stopped:     true,
+		},
+		{
+			name: "stop at fatal",
+			opts: []Option{StopAt(lint.Fatal)},
+			expectedRan: []string{
+				"e_library_usage_test_a_expensive",
+				"e_library_usage_test_b_cheap",
+				"w_library_usage_test_c_cheap",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observer.before = nil
+			res := LintCertificateEx(&x509.Certificate{}, registry, append(tc.opts, WithObserver(observer))...)
+			if !reflect.DeepEqual(observer.before, tc.expectedRan) {
+				t.Errorf("expected lints %v to run, got %v", tc.expectedRan, observer.before)
+			}
+			if len(res.Results) != len(tc.expectedRan) {
+				t.Errorf("expected %d results, got %d", len(tc.expectedRan), len(res.Results))
+			}
+			if res.StoppedEarly != tc.stopped {
+				t.Errorf("expected StoppedEarly to be %v, got %v", tc.stopped, res.StoppedEarly)
+			}
+			if !res.ErrorsPresent {
+				t.Error("expected ErrorsPresent to be set")
+			}
+		})
+	}
+}
+
+func TestLintRawCertificate(t *testing.T) {
+	registry, err := lint.NewRegistryBuilder().
+		ImportNames("e_cert_unparseable", "e_raw_cert_structure_invalid").
+		AddCertificateLint(statusTestLintOf("w_library_usage_test_raw", lint.Warn, lint.DefaultCost)).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unparseable certificates are linted by the raw certificate lints.
+	res := LintRawCertificateEx([]byte{0x30, 0x03, 0x02, 0x01}, registry)
+	if len(res.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(res.Results))
+	}
+	if result := res.Results["e_cert_unparseable"]; result == nil || result.Status != lint.Fatal {
+		t.Errorf("expected a fatal e_cert_unparseable result, got %+v", result)
+	}
+	if result := res.Results["e_raw_cert_structure_invalid"]; result == nil || result.Status != lint.Error {
+		t.Errorf("expected an e_raw_cert_structure_invalid error, got %+v", result)
+	}
+	if !res.FatalsPresent || !res.ErrorsPresent {
+		t.Error("expected FatalsPresent and ErrorsPresent to be set")
+	}
+
+	// Parseable certificates are linted by the certificate lints.
+	block, _ := pem.Decode([]byte(bigCertificatePem))
+	res = LintRawCertificateEx(block.Bytes, registry)
+	if len(res.Results) != 1 || res.Results["w_library_usage_test_raw"] == nil {
+		t.Errorf("expected only the certificate lint to run, got %v", res.Results)
+	}
+}
+
+// certificateOnlyRegistry is a Registry implemented outside of the lint
+// package, which holds no raw certificate lints.
+type certificateOnlyRegistry struct {
+	lint.Registry
+}
+
+func TestLintRawCertificateWithoutRawLints(t *testing.T) {
+	registry, err := lint.NewRegistryBuilder().
+		ImportNames("e_cert_unparseable").
+		AddCertificateLint(statusTestLintOf("w_library_usage_test_raw", lint.Warn, lint.DefaultCost)).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := LintRawCertificateEx([]byte{0x30, 0x03, 0x02, 0x01}, certificateOnlyRegistry{registry})
+	if len(res.Results) != 0 {
+		t.Errorf("expected no results from a registry without raw certificate lints, got %v", res.Results)
+	}
+}